@@ -19,8 +19,12 @@ package abi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/crypto"
 )
 
 // The ABI holds information about a contract's context and available
@@ -145,3 +149,36 @@ func (abi *ABI) MethodById(sigdata []byte) (*Method, error) {
 	}
 	return nil, fmt.Errorf("no method with id: %#x", sigdata[:4])
 }
+
+// revertSelector is a special function selector for revert reason unpacking.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// errBadRevert is returned when the revert data is not an Error(string).
+var errBadRevert = errors.New("invalid revert data")
+
+// PackRevert packs the reason into Solidity compatible Error(string) revert
+// data.
+func PackRevert(reason string) []byte {
+	typ, _ := NewType("string", nil)
+	data, _ := (Arguments{{Type: typ}}).Pack(reason)
+	return append(common.CopyBytes(revertSelector), data...)
+}
+
+// UnpackRevert resolves the abi-encoded revert reason. According to the solidity
+// spec https://solidity.readthedocs.io/en/latest/control-structures.html#revert,
+// the provided revert reason is abi-encoded as if it were a call to a function
+// `Error(string)`. So it's a special tool for it.
+func UnpackRevert(data []byte) (string, error) {
+	if len(data) < 4 {
+		return "", errBadRevert
+	}
+	if !bytes.Equal(data[:4], revertSelector) {
+		return "", errBadRevert
+	}
+	typ, _ := NewType("string", nil)
+	var reason string
+	if err := (Arguments{{Type: typ}}).Unpack(&reason, data[4:]); err != nil {
+		return "", err
+	}
+	return reason, nil
+}
@@ -745,3 +745,22 @@ func TestABI_MethodById(t *testing.T) {
 		t.Errorf("Expected error, nil is short to decode data")
 	}
 }
+
+func TestRevert(t *testing.T) {
+	for _, reason := range []string{"", "caller already registered", strings.Repeat("x", 100)} {
+		data := PackRevert(reason)
+		if !bytes.Equal(data[:4], common.FromHex("0x08c379a0")) {
+			t.Fatalf("wrong selector: %x", data[:4])
+		}
+		got, err := UnpackRevert(data)
+		if err != nil {
+			t.Fatalf("failed to unpack revert data: %v", err)
+		}
+		if got != reason {
+			t.Errorf("reason mismatch: got %q, want %q", got, reason)
+		}
+	}
+	if _, err := UnpackRevert(common.FromHex("0x12345678")); err == nil {
+		t.Error("expected error for non Error(string) data")
+	}
+}
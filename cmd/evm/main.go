@@ -110,6 +110,14 @@ var (
 		Name:  "nostack",
 		Usage: "disable stack output",
 	}
+	RandomnessFlag = cli.StringFlag{
+		Name:  "randomness",
+		Usage: "block randomness (hex) consumed by the RAND instruction",
+	}
+	RoundFlag = cli.Uint64Flag{
+		Name:  "round",
+		Usage: "round number of the execution context",
+	}
 )
 
 func init() {
@@ -133,6 +141,8 @@ func init() {
 		ReceiverFlag,
 		DisableMemoryFlag,
 		DisableStackFlag,
+		RandomnessFlag,
+		RoundFlag,
 	}
 	app.Commands = []cli.Command{
 		compileCommand,
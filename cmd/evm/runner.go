@@ -170,6 +170,8 @@ func runCmd(ctx *cli.Context) error {
 		Time:        new(big.Int).SetUint64(genesisConfig.Timestamp),
 		Coinbase:    genesisConfig.Coinbase,
 		BlockNumber: new(big.Int).SetUint64(genesisConfig.Number),
+		Randomness:  common.FromHex(ctx.GlobalString(RandomnessFlag.Name)),
+		Round:       new(big.Int).SetUint64(ctx.GlobalUint64(RoundFlag.Name)),
 		EVMConfig: vm.Config{
 			Tracer: tracer,
 			Debug:  ctx.GlobalBool(DebugFlag.Name) || ctx.GlobalBool(MachineFlag.Name),
@@ -0,0 +1,66 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// offlineCaptcha is a simple arithmetic challenge handed out to the clients of
+// the faucet when no reCaptcha service is reachable, e.g. on private testnets.
+type offlineCaptcha struct {
+	question string
+	answer   int64
+}
+
+// newOfflineCaptcha creates a fresh arithmetic challenge.
+func newOfflineCaptcha() *offlineCaptcha {
+	a, b := randInt(50)+1, randInt(50)+1
+	if randInt(2) == 0 {
+		return &offlineCaptcha{question: fmt.Sprintf("%d + %d", a, b), answer: a + b}
+	}
+	return &offlineCaptcha{question: fmt.Sprintf("%d × %d", a, b%10+1), answer: a * (b%10 + 1)}
+}
+
+// verify checks whether the given response solves the challenge.
+func (c *offlineCaptcha) verify(response string) bool {
+	answer, err := strconv.ParseInt(strings.TrimSpace(response), 10, 64)
+	return err == nil && answer == c.answer
+}
+
+// randInt returns a uniform random number in [0, max).
+func randInt(max int64) int64 {
+	n, err := rand.Int(rand.Reader, big.NewInt(max))
+	if err != nil {
+		panic(err)
+	}
+	return n.Int64()
+}
+
+// sendCaptcha transmits an offline captcha challenge to the remote end of the
+// websocket, also setting the write deadline to 1 second to prevent waiting forever.
+func sendCaptcha(conn *websocket.Conn, captcha *offlineCaptcha) error {
+	return send(conn, map[string]string{"captcha": captcha.question}, time.Second)
+}
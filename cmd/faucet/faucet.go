@@ -14,7 +14,7 @@
 // You should have received a copy of the GNU General Public License
 // along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
 
-// faucet is a Ether faucet backed by a light client.
+// faucet is a DXN faucet backed by a light client or a DEXON full node.
 package main
 
 //go:generate go-bindata -nometadata -o website.go faucet.html
@@ -41,11 +41,15 @@ import (
 	"sync"
 	"time"
 
+	ethereum "github.com/dexon-foundation/dexon"
 	"github.com/dexon-foundation/dexon/accounts"
 	"github.com/dexon-foundation/dexon/accounts/keystore"
 	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/common/hexutil"
 	"github.com/dexon-foundation/dexon/core"
 	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
 	"github.com/dexon-foundation/dexon/eth"
 	"github.com/dexon-foundation/dexon/eth/downloader"
 	"github.com/dexon-foundation/dexon/ethclient"
@@ -58,6 +62,7 @@ import (
 	"github.com/dexon-foundation/dexon/p2p/enode"
 	"github.com/dexon-foundation/dexon/p2p/nat"
 	"github.com/dexon-foundation/dexon/params"
+	"github.com/dexon-foundation/dexon/rpc"
 	"golang.org/x/net/websocket"
 )
 
@@ -68,20 +73,25 @@ var (
 	bootFlag    = flag.String("bootnodes", "", "Comma separated bootnode enode URLs to seed with")
 	netFlag     = flag.Uint64("network", 0, "Network ID to use for the Ethereum protocol")
 	statsFlag   = flag.String("ethstats", "", "Ethstats network monitoring auth string")
+	rpcFlag     = flag.String("rpc", "", "Websocket or IPC endpoint of a DEXON full node to use instead of the light client")
 
 	netnameFlag = flag.String("faucet.name", "", "Network name to assign to the faucet")
-	payoutFlag  = flag.Int("faucet.amount", 1, "Number of Ethers to pay out per user request")
+	payoutFlag  = flag.Int("faucet.amount", 1, "Number of DXN to pay out per user request")
 	minutesFlag = flag.Int("faucet.minutes", 1440, "Number of minutes to wait between funding rounds")
 	tiersFlag   = flag.Int("faucet.tiers", 3, "Number of funding tiers to enable (x3 time, x2.5 funds)")
+	roundFlag   = flag.Int("faucet.perround", 1, "Number of requests allowed per address in a DEXON round (0 = unlimited)")
+	allowFlag   = flag.String("faucet.allowlist", "", "File with the addresses allowed to request funds, one per line")
+	stakeFlag   = flag.Bool("faucet.stake", false, "Enables registering and staking nodes for node operators")
 
 	accJSONFlag = flag.String("account.json", "", "Key json file to fund user requests with")
 	accPassFlag = flag.String("account.pass", "", "Decryption password to access faucet funds")
 
 	captchaToken  = flag.String("captcha.token", "", "Recaptcha site key to authenticate client side")
 	captchaSecret = flag.String("captcha.secret", "", "Recaptcha secret key to authenticate server side")
+	captchaLocal  = flag.Bool("captcha.offline", false, "Enables a built-in arithmetic captcha working without internet access")
 
 	noauthFlag = flag.Bool("noauth", false, "Enables funding requests without authentication")
-	logFlag    = flag.Int("loglevel", 3, "Log level to use for Ethereum and the faucet")
+	logFlag    = flag.Int("loglevel", 3, "Log level to use for DEXON and the faucet")
 )
 
 var (
@@ -93,16 +103,16 @@ func main() {
 	flag.Parse()
 	log.Root().SetHandler(log.LvlFilterHandler(log.Lvl(*logFlag), log.StreamHandler(os.Stderr, log.TerminalFormat(true))))
 
+	if *captchaToken != "" && *captchaLocal {
+		log.Crit("Online and offline captchas are mutually exclusive")
+	}
 	// Construct the payout tiers
 	amounts := make([]string, *tiersFlag)
 	periods := make([]string, *tiersFlag)
 	for i := 0; i < *tiersFlag; i++ {
 		// Calculate the amount for the next tier and format it
 		amount := float64(*payoutFlag) * math.Pow(2.5, float64(i))
-		amounts[i] = fmt.Sprintf("%s Ethers", strconv.FormatFloat(amount, 'f', -1, 64))
-		if amount == 1 {
-			amounts[i] = strings.TrimSuffix(amounts[i], "s")
-		}
+		amounts[i] = fmt.Sprintf("%s DXN", strconv.FormatFloat(amount, 'f', -1, 64))
 		// Calculate the period for the next tier and format it
 		period := *minutesFlag * int(math.Pow(3, float64(i)))
 		periods[i] = fmt.Sprintf("%d mins", period)
@@ -130,7 +140,10 @@ func main() {
 		"Amounts":   amounts,
 		"Periods":   periods,
 		"Recaptcha": *captchaToken,
+		"Offline":   *captchaLocal,
 		"NoAuth":    *noauthFlag,
+		"Allowlist": *allowFlag != "",
+		"Stake":     *stakeFlag,
 	})
 	if err != nil {
 		log.Crit("Failed to render the faucet template", "err", err)
@@ -170,13 +183,27 @@ func main() {
 	}
 	ks.Unlock(acc, pass)
 
-	// Assemble and start the faucet light service
-	faucet, err := newFaucet(genesis, *ethPortFlag, enodes, *netFlag, *statsFlag, ks, website.Bytes())
+	// Load the addresses allowed to request funds, if restricted
+	var allowlist map[common.Address]bool
+	if *allowFlag != "" {
+		if allowlist, err = loadAllowlist(*allowFlag); err != nil {
+			log.Crit("Failed to load faucet allowlist", "file", *allowFlag, "err", err)
+		}
+	}
+	// Assemble and start the faucet light service or connect to the full node
+	var faucet *faucet
+	if *rpcFlag != "" {
+		faucet, err = newRPCFaucet(genesis, *rpcFlag, ks, website.Bytes())
+	} else {
+		faucet, err = newFaucet(genesis, *ethPortFlag, enodes, *netFlag, *statsFlag, ks, website.Bytes())
+	}
 	if err != nil {
 		log.Crit("Failed to start faucet", "err", err)
 	}
 	defer faucet.close()
 
+	faucet.allowlist = allowlist
+
 	if err := faucet.listenAndServe(*apiPortFlag); err != nil {
 		log.Crit("Failed to launch faucet API", "err", err)
 	}
@@ -185,18 +212,48 @@ func main() {
 // request represents an accepted funding request.
 type request struct {
 	Avatar  string             `json:"avatar"`  // Avatar URL to make the UI nicer
-	Account common.Address     `json:"account"` // Ethereum address being funded
+	Account common.Address     `json:"account"` // DEXON address being funded
 	Time    time.Time          `json:"time"`    // Timestamp when the request was accepted
 	Tx      *types.Transaction `json:"tx"`      // Transaction funding the account
 }
 
-// faucet represents a crypto faucet backed by an Ethereum light client.
+// nodeRequest is the node information a node operator requests to register.
+type nodeRequest struct {
+	PublicKey hexutil.Bytes `json:"publicKey"`
+	Name      string        `json:"name"`
+	Email     string        `json:"email"`
+	Location  string        `json:"location"`
+	URL       string        `json:"url"`
+}
+
+// quota tracks the number of requests of an address within a round.
+type quota struct {
+	round uint64
+	count int
+}
+
+// chainClient is the access to the DEXON chain the faucet needs, provided by an
+// ethclient connection to the backing node.
+type chainClient interface {
+	ethereum.ChainStateReader
+	ethereum.ContractCaller
+	ethereum.GasPricer
+	ethereum.TransactionSender
+
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+}
+
+// faucet represents a crypto faucet backed by a DEXON light client or full node.
 type faucet struct {
 	config *params.ChainConfig // Chain configurations for signing
-	stack  *node.Node          // Ethereum protocol stack
-	client *ethclient.Client   // Client connection to the Ethereum chain
+	stack  *node.Node          // Light client protocol stack, nil if backed by a full node
+	rpc    *rpc.Client         // RPC connection to the backing node
+	client chainClient         // Client connection to the DEXON chain
 	index  []byte              // Index page to serve up on the web
 
+	allowlist map[common.Address]bool // Addresses allowed to request funds, nil if unrestricted
+
 	keystore *keystore.KeyStore // Keystore containing the single signer
 	account  accounts.Account   // Account funding user faucet requests
 	head     *types.Header      // Current head header of the faucet
@@ -204,10 +261,11 @@ type faucet struct {
 	nonce    uint64             // Current pending nonce of the faucet
 	price    *big.Int           // Current gas price to issue funds with
 
-	conns    []*websocket.Conn    // Currently live websocket connections
-	timeouts map[string]time.Time // History of users and their funding timeouts
-	reqs     []*request           // Currently pending funding requests
-	update   chan struct{}        // Channel to signal request updates
+	conns    []*websocket.Conn         // Currently live websocket connections
+	timeouts map[string]time.Time      // History of users and their funding timeouts
+	quotas   map[common.Address]*quota // Requests of addresses in the current round
+	reqs     []*request                // Currently pending funding requests
+	update   chan struct{}             // Channel to signal request updates
 
 	lock sync.RWMutex // Lock protecting the faucet's internals
 }
@@ -245,7 +303,7 @@ func newFaucet(genesis *core.Genesis, port int, enodes []*discv5.Node, network u
 		if err := stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
 			var serv *les.LightEthereum
 			ctx.Service(&serv)
-			return ethstats.New(stats, nil, serv)
+			return ethstats.New(stats, nil, serv, nil)
 		}); err != nil {
 			return nil, err
 		}
@@ -271,20 +329,59 @@ func newFaucet(genesis *core.Genesis, port int, enodes []*discv5.Node, network u
 	return &faucet{
 		config:   genesis.Config,
 		stack:    stack,
+		rpc:      api,
 		client:   client,
 		index:    index,
 		keystore: ks,
 		account:  ks.Accounts()[0],
 		timeouts: make(map[string]time.Time),
+		quotas:   make(map[common.Address]*quota),
 		update:   make(chan struct{}, 1),
 	}, nil
 }
 
-// close terminates the Ethereum connection and tears down the faucet.
+// newRPCFaucet creates a faucet issuing funds through the DEXON full node at
+// the given websocket or IPC endpoint.
+func newRPCFaucet(genesis *core.Genesis, endpoint string, ks *keystore.KeyStore, index []byte) (*faucet, error) {
+	api, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &faucet{
+		config:   genesis.Config,
+		rpc:      api,
+		client:   ethclient.NewClient(api),
+		index:    index,
+		keystore: ks,
+		account:  ks.Accounts()[0],
+		timeouts: make(map[string]time.Time),
+		quotas:   make(map[common.Address]*quota),
+		update:   make(chan struct{}, 1),
+	}, nil
+}
+
+// close terminates the DEXON connection and tears down the faucet.
 func (f *faucet) close() error {
+	if f.stack == nil {
+		f.rpc.Close()
+		return nil
+	}
 	return f.stack.Stop()
 }
 
+// peerCount returns the number of peers of the node backing the faucet.
+func (f *faucet) peerCount() int {
+	if f.stack != nil {
+		return f.stack.Server().PeerCount()
+	}
+	var peers hexutil.Uint
+	if err := f.rpc.Call(&peers, "net_peerCount"); err != nil {
+		log.Warn("Failed to retrieve peer count", "err", err)
+		return 0
+	}
+	return int(peers)
+}
+
 // listenAndServe registers the HTTP handlers for the faucet and boots it up
 // for service user funding requests.
 func (f *faucet) listenAndServe(port int) error {
@@ -302,7 +399,8 @@ func (f *faucet) webHandler(w http.ResponseWriter, r *http.Request) {
 	w.Write(f.index)
 }
 
-// apiHandler handles requests for Ether grants and transaction statuses.
+// apiHandler handles requests for DXN grants, node registrations and transaction
+// statuses.
 func (f *faucet) apiHandler(conn *websocket.Conn) {
 	// Start tracking the connection and drop at the end
 	defer conn.Close()
@@ -353,7 +451,7 @@ func (f *faucet) apiHandler(conn *websocket.Conn) {
 	if err = send(conn, map[string]interface{}{
 		"funds":    new(big.Int).Div(balance, ether),
 		"funded":   nonce,
-		"peers":    f.stack.Server().PeerCount(),
+		"peers":    f.peerCount(),
 		"requests": f.reqs,
 	}, 3*time.Second); err != nil {
 		log.Warn("Failed to send initial stats to client", "err", err)
@@ -363,18 +461,28 @@ func (f *faucet) apiHandler(conn *websocket.Conn) {
 		log.Warn("Failed to send initial header to client", "err", err)
 		return
 	}
+	// If offline captchas are enabled, hand out the first challenge
+	var challenge *offlineCaptcha
+	if *captchaLocal {
+		challenge = newOfflineCaptcha()
+		if err = sendCaptcha(conn, challenge); err != nil {
+			log.Warn("Failed to send captcha to client", "err", err)
+			return
+		}
+	}
 	// Keep reading requests from the websocket until the connection breaks
 	for {
 		// Fetch the next funding request and validate against github
 		var msg struct {
-			URL     string `json:"url"`
-			Tier    uint   `json:"tier"`
-			Captcha string `json:"captcha"`
+			URL     string       `json:"url"`
+			Tier    uint         `json:"tier"`
+			Captcha string       `json:"captcha"`
+			Node    *nodeRequest `json:"node"`
 		}
 		if err = websocket.JSON.Receive(conn, &msg); err != nil {
 			return
 		}
-		if !*noauthFlag && !strings.HasPrefix(msg.URL, "https://gist.github.com/") && !strings.HasPrefix(msg.URL, "https://twitter.com/") &&
+		if !*noauthFlag && f.allowlist == nil && !strings.HasPrefix(msg.URL, "https://gist.github.com/") && !strings.HasPrefix(msg.URL, "https://twitter.com/") &&
 			!strings.HasPrefix(msg.URL, "https://plus.google.com/") && !strings.HasPrefix(msg.URL, "https://www.facebook.com/") {
 			if err = sendError(conn, errors.New("URL doesn't link to supported services")); err != nil {
 				log.Warn("Failed to send URL error to client", "err", err)
@@ -389,7 +497,31 @@ func (f *faucet) apiHandler(conn *websocket.Conn) {
 			}
 			continue
 		}
-		log.Info("Faucet funds requested", "url", msg.URL, "tier", msg.Tier)
+		if msg.Node != nil && !*stakeFlag {
+			if err = sendError(conn, errors.New("Node registration disabled")); err != nil {
+				log.Warn("Failed to send registration error to client", "err", err)
+				return
+			}
+			continue
+		}
+		log.Info("Faucet funds requested", "url", msg.URL, "tier", msg.Tier, "node", msg.Node != nil)
+
+		// If offline captchas are enabled, check the answer and hand out a new challenge
+		if challenge != nil {
+			solved := challenge.verify(msg.Captcha)
+			challenge = newOfflineCaptcha()
+			if err = sendCaptcha(conn, challenge); err != nil {
+				log.Warn("Failed to send captcha to client", "err", err)
+				return
+			}
+			if !solved {
+				if err = sendError(conn, errors.New("Beep-bop, you're a robot!")); err != nil {
+					log.Warn("Failed to send captcha failure to client", "err", err)
+					return
+				}
+				continue
+			}
+		}
 
 		// If captcha verifications are enabled, make sure we're not dealing with a robot
 		if *captchaToken != "" {
@@ -427,13 +559,15 @@ func (f *faucet) apiHandler(conn *websocket.Conn) {
 				continue
 			}
 		}
-		// Retrieve the Ethereum address to fund, the requesting user and a profile picture
+		// Retrieve the DEXON address to fund, the requesting user and a profile picture
 		var (
 			username string
 			avatar   string
 			address  common.Address
 		)
 		switch {
+		case f.allowlist != nil:
+			username, avatar, address, err = authAllowlist(msg.URL, f.allowlist)
 		case strings.HasPrefix(msg.URL, "https://gist.github.com/"):
 			if err = sendError(conn, errors.New("GitHub authentication discontinued at the official request of GitHub")); err != nil {
 				log.Warn("Failed to send GitHub deprecation to client", "err", err)
@@ -460,44 +594,69 @@ func (f *faucet) apiHandler(conn *websocket.Conn) {
 		}
 		log.Info("Faucet request valid", "url", msg.URL, "tier", msg.Tier, "user", username, "address", address)
 
-		// Ensure the user didn't request funds too recently
+		// Assemble the transactions to issue, registering a node or funding an account
+		var txs []*types.Transaction
+		if msg.Node != nil {
+			txs, err = f.registerTxs(address, msg.Node)
+		} else {
+			amount := new(big.Int).Mul(big.NewInt(int64(*payoutFlag)), ether)
+			amount = new(big.Int).Mul(amount, new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(msg.Tier)), nil))
+			amount = new(big.Int).Div(amount, new(big.Int).Exp(big.NewInt(2), big.NewInt(int64(msg.Tier)), nil))
+
+			txs = []*types.Transaction{types.NewTransaction(0, address, amount, 21000, nil, nil)}
+		}
+		if err != nil {
+			if err = sendError(conn, err); err != nil {
+				log.Warn("Failed to send registration error to client", "err", err)
+				return
+			}
+			continue
+		}
+		// Ensure the user didn't request funds too recently or too often in this round
 		f.lock.Lock()
 		var (
 			fund    bool
 			timeout time.Time
+			round   = f.head.Round
 		)
+		if f.quotaReached(address, round) {
+			f.lock.Unlock()
+			if err = sendError(conn, fmt.Errorf("Request limit of round %d reached", round)); err != nil {
+				log.Warn("Failed to send quota error to client", "err", err)
+				return
+			}
+			continue
+		}
 		if timeout = f.timeouts[username]; time.Now().After(timeout) {
-			// User wasn't funded recently, create the funding transaction
-			amount := new(big.Int).Mul(big.NewInt(int64(*payoutFlag)), ether)
-			amount = new(big.Int).Mul(amount, new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(msg.Tier)), nil))
-			amount = new(big.Int).Div(amount, new(big.Int).Exp(big.NewInt(2), big.NewInt(int64(msg.Tier)), nil))
-
-			tx := types.NewTransaction(f.nonce+uint64(len(f.reqs)), address, amount, 21000, f.price, nil)
-			signed, err := f.keystore.SignTx(f.account, tx, f.config.ChainID)
+			// User wasn't funded recently, sign and submit the transactions,
+			// tracking the ones sent even if a later one failed
+			signed, err := f.sendTxs(txs)
+			for _, tx := range signed {
+				f.reqs = append(f.reqs, &request{
+					Avatar:  avatar,
+					Account: address,
+					Time:    time.Now(),
+					Tx:      tx,
+				})
+			}
+			if len(signed) > 0 {
+				f.timeouts[username] = time.Now().Add(time.Duration(*minutesFlag*int(math.Pow(3, float64(msg.Tier)))) * time.Minute)
+				f.useQuota(address, round)
+			}
 			if err != nil {
 				f.lock.Unlock()
-				if err = sendError(conn, err); err != nil {
-					log.Warn("Failed to send transaction creation error to client", "err", err)
-					return
+				if len(signed) > 0 {
+					select {
+					case f.update <- struct{}{}:
+					default:
+					}
 				}
-				continue
-			}
-			// Submit the transaction and mark as funded if successful
-			if err := f.client.SendTransaction(context.Background(), signed); err != nil {
-				f.lock.Unlock()
 				if err = sendError(conn, err); err != nil {
 					log.Warn("Failed to send transaction transmission error to client", "err", err)
 					return
 				}
 				continue
 			}
-			f.reqs = append(f.reqs, &request{
-				Avatar:  avatar,
-				Account: address,
-				Time:    time.Now(),
-				Tx:      signed,
-			})
-			f.timeouts[username] = time.Now().Add(time.Duration(*minutesFlag*int(math.Pow(3, float64(msg.Tier)))) * time.Minute)
 			fund = true
 		}
 		f.lock.Unlock()
@@ -510,7 +669,11 @@ func (f *faucet) apiHandler(conn *websocket.Conn) {
 			}
 			continue
 		}
-		if err = sendSuccess(conn, fmt.Sprintf("Funding request accepted for %s into %s", username, address.Hex())); err != nil {
+		success := fmt.Sprintf("Funding request accepted for %s into %s", username, address.Hex())
+		if msg.Node != nil {
+			success = fmt.Sprintf("Node registration accepted for %s owned by %s", username, address.Hex())
+		}
+		if err = sendSuccess(conn, success); err != nil {
 			log.Warn("Failed to send funding success to client", "err", err)
 			return
 		}
@@ -557,11 +720,124 @@ func (f *faucet) refresh(head *types.Header) error {
 	for len(f.reqs) > 0 && f.reqs[0].Tx.Nonce() < f.nonce {
 		f.reqs = f.reqs[1:]
 	}
+	for address, used := range f.quotas {
+		if used.round < head.Round {
+			delete(f.quotas, address)
+		}
+	}
 	f.lock.Unlock()
 
 	return nil
 }
 
+// quotaReached returns whether an address used up its requests in the round.
+// The faucet lock must be held.
+func (f *faucet) quotaReached(address common.Address, round uint64) bool {
+	used := f.quotas[address]
+	return used != nil && used.round == round && *roundFlag > 0 && used.count >= *roundFlag
+}
+
+// useQuota counts a request of an address in the round. The faucet lock must
+// be held.
+func (f *faucet) useQuota(address common.Address, round uint64) {
+	used := f.quotas[address]
+	if used == nil || used.round != round {
+		used = &quota{round: round}
+		f.quotas[address] = used
+	}
+	used.count++
+}
+
+// registerGas is the gas allowance of the node registration transactions.
+const registerGas = 300000
+
+// callGovernance executes a read only call of the governance contract on the
+// latest state and unpacks the result into out.
+func (f *faucet) callGovernance(ctx context.Context, out interface{}, method string, args ...interface{}) error {
+	input, err := vm.GovernanceABI.ABI.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+	output, err := f.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &vm.GovernanceContractAddress,
+		Data: input,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	return vm.GovernanceABI.ABI.Unpack(out, method, output)
+}
+
+// registerTxs assembles the transactions registering and staking a node with
+// the faucet funds and handing its ownership over to the requesting operator.
+// The returned transactions are templates, nonces and gas prices are filled in
+// when sending them.
+func (f *faucet) registerTxs(owner common.Address, node *nodeRequest) ([]*types.Transaction, error) {
+	key, err := crypto.UnmarshalPubkey(node.PublicKey)
+	if err != nil {
+		return nil, errors.New("Invalid node public key")
+	}
+	if len(node.Name) >= 32 || len(node.Email) >= 32 || len(node.Location) >= 32 || len(node.URL) >= 128 {
+		return nil, errors.New("Node information too long")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Ensure neither the operator nor the node key is registered already
+	offset := new(big.Int)
+	if err := f.callGovernance(ctx, &offset, "nodesOffsetByAddress", owner); err != nil {
+		return nil, err
+	}
+	if offset.Sign() >= 0 {
+		return nil, errors.New("Operator already owns a node")
+	}
+	if err := f.callGovernance(ctx, &offset, "nodesOffsetByNodeKeyAddress", crypto.PubkeyToAddress(*key)); err != nil {
+		return nil, err
+	}
+	if offset.Sign() >= 0 {
+		return nil, errors.New("Node key already registered")
+	}
+	// Stake the minimum required by the governance and hand the node over
+	stake := new(big.Int)
+	if err := f.callGovernance(ctx, &stake, "minStake"); err != nil {
+		return nil, err
+	}
+	register, err := vm.GovernanceABI.ABI.Pack("register", []byte(node.PublicKey), node.Name, node.Email, node.Location, node.URL)
+	if err != nil {
+		return nil, err
+	}
+	transfer, err := vm.GovernanceABI.ABI.Pack("transferNodeOwnership", owner)
+	if err != nil {
+		return nil, err
+	}
+	return []*types.Transaction{
+		types.NewTransaction(0, vm.GovernanceContractAddress, stake, registerGas, nil, register),
+		types.NewTransaction(0, vm.GovernanceContractAddress, new(big.Int), registerGas, nil, transfer),
+	}, nil
+}
+
+// sendTxs signs the given transaction templates with the next nonces of the
+// faucet account and submits them to the network. All of them are signed before
+// any is sent, and if sending fails, the ones already submitted are returned
+// along with the error as their nonces are taken. The faucet lock must be held.
+func (f *faucet) sendTxs(txs []*types.Transaction) ([]*types.Transaction, error) {
+	signed := make([]*types.Transaction, 0, len(txs))
+	for i, tx := range txs {
+		tx = types.NewTransaction(f.nonce+uint64(len(f.reqs)+i), *tx.To(), tx.Value(), tx.Gas(), f.price, tx.Data())
+		tx, err := f.keystore.SignTx(f.account, tx, f.config.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		signed = append(signed, tx)
+	}
+	for i, tx := range signed {
+		if err := f.client.SendTransaction(context.Background(), tx); err != nil {
+			return signed[:i], err
+		}
+	}
+	return signed, nil
+}
+
 // loop keeps waiting for interesting events and pushes them out to connected
 // websockets.
 func (f *faucet) loop() {
@@ -579,7 +855,7 @@ func (f *faucet) loop() {
 	go func() {
 		for head := range update {
 			// New chain head arrived, query the current stats and stream to clients
-			timestamp := time.Unix(int64(head.Time), 0)
+			timestamp := time.Unix(0, int64(head.Time)*int64(time.Millisecond))
 			if time.Since(timestamp) > time.Hour {
 				log.Warn("Skipping faucet refresh, head too old", "number", head.Number, "hash", head.Hash(), "age", common.PrettyAge(timestamp))
 				continue
@@ -593,7 +869,7 @@ func (f *faucet) loop() {
 			log.Info("Updated faucet state", "number", head.Number, "hash", head.Hash(), "age", common.PrettyAge(timestamp), "balance", f.balance, "nonce", f.nonce, "price", f.price)
 
 			balance := new(big.Int).Div(f.balance, ether)
-			peers := f.stack.Server().PeerCount()
+			peers := f.peerCount()
 
 			for _, conn := range f.conns {
 				if err := send(conn, map[string]interface{}{
@@ -661,7 +937,7 @@ func sendSuccess(conn *websocket.Conn, msg string) error {
 }
 
 // authTwitter tries to authenticate a faucet request using Twitter posts, returning
-// the username, avatar URL and Ethereum address to fund on success.
+// the username, avatar URL and DEXON address to fund on success.
 func authTwitter(url string) (string, string, common.Address, error) {
 	// Ensure the user specified a meaningful URL, no fancy nonsense
 	parts := strings.Split(url, "/")
@@ -670,7 +946,7 @@ func authTwitter(url string) (string, string, common.Address, error) {
 	}
 	// Twitter's API isn't really friendly with direct links. Still, we don't
 	// want to do ask read permissions from users, so just load the public posts and
-	// scrape it for the Ethereum address and profile URL.
+	// scrape it for the DEXON address and profile URL.
 	res, err := http.Get(url)
 	if err != nil {
 		return "", "", common.Address{}, err
@@ -690,7 +966,7 @@ func authTwitter(url string) (string, string, common.Address, error) {
 	}
 	address := common.HexToAddress(string(regexp.MustCompile("0x[0-9a-fA-F]{40}").Find(body)))
 	if address == (common.Address{}) {
-		return "", "", common.Address{}, errors.New("No Ethereum address found to fund")
+		return "", "", common.Address{}, errors.New("No DEXON address found to fund")
 	}
 	var avatar string
 	if parts = regexp.MustCompile("src=\"([^\"]+twimg.com/profile_images[^\"]+)\"").FindStringSubmatch(string(body)); len(parts) == 2 {
@@ -700,7 +976,7 @@ func authTwitter(url string) (string, string, common.Address, error) {
 }
 
 // authGooglePlus tries to authenticate a faucet request using GooglePlus posts,
-// returning the username, avatar URL and Ethereum address to fund on success.
+// returning the username, avatar URL and DEXON address to fund on success.
 func authGooglePlus(url string) (string, string, common.Address, error) {
 	// Ensure the user specified a meaningful URL, no fancy nonsense
 	parts := strings.Split(url, "/")
@@ -711,7 +987,7 @@ func authGooglePlus(url string) (string, string, common.Address, error) {
 
 	// Google's API isn't really friendly with direct links. Still, we don't
 	// want to do ask read permissions from users, so just load the public posts and
-	// scrape it for the Ethereum address and profile URL.
+	// scrape it for the DEXON address and profile URL.
 	res, err := http.Get(url)
 	if err != nil {
 		return "", "", common.Address{}, err
@@ -724,7 +1000,7 @@ func authGooglePlus(url string) (string, string, common.Address, error) {
 	}
 	address := common.HexToAddress(string(regexp.MustCompile("0x[0-9a-fA-F]{40}").Find(body)))
 	if address == (common.Address{}) {
-		return "", "", common.Address{}, errors.New("No Ethereum address found to fund")
+		return "", "", common.Address{}, errors.New("No DEXON address found to fund")
 	}
 	var avatar string
 	if parts = regexp.MustCompile("src=\"([^\"]+googleusercontent.com[^\"]+photo.jpg)\"").FindStringSubmatch(string(body)); len(parts) == 2 {
@@ -734,7 +1010,7 @@ func authGooglePlus(url string) (string, string, common.Address, error) {
 }
 
 // authFacebook tries to authenticate a faucet request using Facebook posts,
-// returning the username, avatar URL and Ethereum address to fund on success.
+// returning the username, avatar URL and DEXON address to fund on success.
 func authFacebook(url string) (string, string, common.Address, error) {
 	// Ensure the user specified a meaningful URL, no fancy nonsense
 	parts := strings.Split(url, "/")
@@ -745,7 +1021,7 @@ func authFacebook(url string) (string, string, common.Address, error) {
 
 	// Facebook's Graph API isn't really friendly with direct links. Still, we don't
 	// want to do ask read permissions from users, so just load the public posts and
-	// scrape it for the Ethereum address and profile URL.
+	// scrape it for the DEXON address and profile URL.
 	res, err := http.Get(url)
 	if err != nil {
 		return "", "", common.Address{}, err
@@ -758,7 +1034,7 @@ func authFacebook(url string) (string, string, common.Address, error) {
 	}
 	address := common.HexToAddress(string(regexp.MustCompile("0x[0-9a-fA-F]{40}").Find(body)))
 	if address == (common.Address{}) {
-		return "", "", common.Address{}, errors.New("No Ethereum address found to fund")
+		return "", "", common.Address{}, errors.New("No DEXON address found to fund")
 	}
 	var avatar string
 	if parts = regexp.MustCompile("src=\"([^\"]+fbcdn.net[^\"]+)\"").FindStringSubmatch(string(body)); len(parts) == 2 {
@@ -767,13 +1043,49 @@ func authFacebook(url string) (string, string, common.Address, error) {
 	return username + "@facebook", avatar, address, nil
 }
 
-// authNoAuth tries to interpret a faucet request as a plain Ethereum address,
+// authNoAuth tries to interpret a faucet request as a plain DEXON address,
 // without actually performing any remote authentication. This mode is prone to
 // Byzantine attack, so only ever use for truly private networks.
 func authNoAuth(url string) (string, string, common.Address, error) {
 	address := common.HexToAddress(regexp.MustCompile("0x[0-9a-fA-F]{40}").FindString(url))
 	if address == (common.Address{}) {
-		return "", "", common.Address{}, errors.New("No Ethereum address found to fund")
+		return "", "", common.Address{}, errors.New("No DEXON address found to fund")
 	}
 	return address.Hex() + "@noauth", "", address, nil
 }
+
+// authAllowlist tries to interpret a faucet request as a plain DEXON address,
+// accepting it only if the address is in the allowlist of the faucet.
+func authAllowlist(url string, allowlist map[common.Address]bool) (string, string, common.Address, error) {
+	address := common.HexToAddress(regexp.MustCompile("0x[0-9a-fA-F]{40}").FindString(url))
+	if address == (common.Address{}) {
+		return "", "", common.Address{}, errors.New("No DEXON address found to fund")
+	}
+	if !allowlist[address] {
+		return "", "", common.Address{}, errors.New("Address not allowed to request funds")
+	}
+	return address.Hex() + "@allowlist", "", address, nil
+}
+
+// loadAllowlist reads the addresses allowed to request funds from a file
+// containing one address per line. Empty lines and # comments are ignored.
+func loadAllowlist(path string) (map[common.Address]bool, error) {
+	blob, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	allowlist := make(map[common.Address]bool)
+	for i, line := range strings.Split(string(blob), "\n") {
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		if !common.IsHexAddress(line) {
+			return nil, fmt.Errorf("line %d: invalid address %q", i+1, line)
+		}
+		allowlist[common.HexToAddress(line)] = true
+	}
+	return allowlist, nil
+}
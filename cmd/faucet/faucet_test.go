@@ -0,0 +1,264 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethereum "github.com/dexon-foundation/dexon"
+	"github.com/dexon-foundation/dexon/accounts/abi/bind/backends"
+	"github.com/dexon-foundation/dexon/accounts/keystore"
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/params"
+)
+
+var errSendFailed = errors.New("send failed")
+
+// simulatedClient backs a faucet with a simulated chain.
+type simulatedClient struct {
+	*backends.SimulatedBackend
+
+	sends int // Number of transactions accepted before failing, -1 if unlimited
+}
+
+func (c *simulatedClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *simulatedClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *simulatedClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if c.sends == 0 {
+		return errSendFailed
+	}
+	c.sends--
+	return c.SimulatedBackend.SendTransaction(ctx, tx)
+}
+
+// newTestFaucet creates a faucet backed by a simulated chain, with a funded
+// account in a temporary keystore.
+func newTestFaucet(t *testing.T) (*faucet, *simulatedClient, func()) {
+	dir, err := ioutil.TempDir("", "faucet-test")
+	if err != nil {
+		t.Fatalf("failed to create keystore dir: %v", err)
+	}
+	ks := keystore.NewKeyStore(dir, keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.NewAccount("")
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+	if err := ks.Unlock(account, ""); err != nil {
+		t.Fatalf("failed to unlock account: %v", err)
+	}
+	client := &simulatedClient{
+		SimulatedBackend: backends.NewSimulatedBackend(core.GenesisAlloc{
+			account.Address: {Balance: new(big.Int).Mul(big.NewInt(1000), ether)},
+		}, 8000000),
+		sends: -1,
+	}
+	f := &faucet{
+		config:   &params.ChainConfig{},
+		client:   client,
+		keystore: ks,
+		account:  account,
+		price:    big.NewInt(1),
+		quotas:   make(map[common.Address]*quota),
+	}
+	return f, client, func() { os.RemoveAll(dir) }
+}
+
+func TestRegisterTxs(t *testing.T) {
+	f, client, cleanup := newTestFaucet(t)
+	defer cleanup()
+
+	key, _ := crypto.GenerateKey()
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	node := &nodeRequest{
+		PublicKey: crypto.FromECDSAPub(&key.PublicKey),
+		Name:      "node",
+		Email:     "node@dexon.org",
+		Location:  "Taipei",
+		URL:       "https://dexon.org",
+	}
+	txs, err := f.registerTxs(owner, node)
+	if err != nil {
+		t.Fatalf("failed to assemble registration: %v", err)
+	}
+	if len(txs) != 2 {
+		t.Fatalf("transaction count mismatch: have %d, want 2", len(txs))
+	}
+	register, _ := vm.GovernanceABI.ABI.Pack("register", []byte(node.PublicKey), node.Name, node.Email, node.Location, node.URL)
+	transfer, _ := vm.GovernanceABI.ABI.Pack("transferNodeOwnership", owner)
+	for i, data := range [][]byte{register, transfer} {
+		if *txs[i].To() != vm.GovernanceContractAddress {
+			t.Errorf("tx %d: recipient mismatch: have %x", i, txs[i].To())
+		}
+		if !bytes.Equal(txs[i].Data(), data) {
+			t.Errorf("tx %d: call data mismatch: have %x, want %x", i, txs[i].Data(), data)
+		}
+	}
+
+	// Malformed requests are rejected before anything is sent.
+	if _, err := f.registerTxs(owner, &nodeRequest{PublicKey: []byte{1, 2, 3}}); err == nil {
+		t.Errorf("invalid public key accepted")
+	}
+	long := *node
+	long.Name = string(make([]byte, 32))
+	if _, err := f.registerTxs(owner, &long); err == nil {
+		t.Errorf("over long node name accepted")
+	}
+
+	// Once the node is registered, neither its key nor its operator may
+	// register again.
+	if _, err := f.sendTxs(txs[:1]); err != nil {
+		t.Fatalf("failed to send registration: %v", err)
+	}
+	client.Commit()
+
+	other, _ := crypto.GenerateKey()
+	again := *node
+	again.PublicKey = crypto.FromECDSAPub(&other.PublicKey)
+	if _, err := f.registerTxs(f.account.Address, &again); err == nil {
+		t.Errorf("second node of an operator accepted")
+	}
+	if _, err := f.registerTxs(owner, node); err == nil {
+		t.Errorf("registered node key accepted")
+	}
+}
+
+func TestSendTxsPartialFailure(t *testing.T) {
+	f, client, cleanup := newTestFaucet(t)
+	defer cleanup()
+
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	txs := []*types.Transaction{
+		types.NewTransaction(0, to, big.NewInt(1), 21000, nil, nil),
+		types.NewTransaction(0, to, big.NewInt(2), 21000, nil, nil),
+	}
+	client.sends = 1
+
+	sent, err := f.sendTxs(txs)
+	if err != errSendFailed {
+		t.Fatalf("error mismatch: have %v, want %v", err, errSendFailed)
+	}
+	if len(sent) != 1 || sent[0].Nonce() != 0 || sent[0].Value().Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("sent transactions mismatch: have %v", sent)
+	}
+
+	// Once the sent transaction is tracked, the next request continues with
+	// the following nonce.
+	f.reqs = append(f.reqs, &request{Tx: sent[0]})
+	client.sends = -1
+	sent, err = f.sendTxs(txs[1:])
+	if err != nil {
+		t.Fatalf("failed to send transaction: %v", err)
+	}
+	if sent[0].Nonce() != 1 {
+		t.Errorf("nonce mismatch: have %d, want 1", sent[0].Nonce())
+	}
+}
+
+func TestRoundQuota(t *testing.T) {
+	defer func(limit int) { *roundFlag = limit }(*roundFlag)
+	*roundFlag = 2
+
+	f := &faucet{quotas: make(map[common.Address]*quota)}
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	for i := 0; i < 2; i++ {
+		if f.quotaReached(address, 1) {
+			t.Fatalf("request %d: quota reached early", i)
+		}
+		f.useQuota(address, 1)
+	}
+	if !f.quotaReached(address, 1) {
+		t.Errorf("quota not reached after %d requests", *roundFlag)
+	}
+	if f.quotaReached(common.Address{}, 1) {
+		t.Errorf("quota of another address reached")
+	}
+	// The quota is restored in the next round.
+	if f.quotaReached(address, 2) {
+		t.Errorf("quota reached in the next round")
+	}
+	f.useQuota(address, 2)
+	if used := f.quotas[address]; used.round != 2 || used.count != 1 {
+		t.Errorf("quota mismatch: have round %d count %d, want round 2 count 1", used.round, used.count)
+	}
+
+	*roundFlag = 0
+	f.useQuota(address, 2)
+	if f.quotaReached(address, 2) {
+		t.Errorf("quota reached without a limit")
+	}
+}
+
+func TestAllowlist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "faucet-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	allowed := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	path := filepath.Join(dir, "allowlist")
+	content := "# testnet operators\n\n" + allowed.Hex() + " # operator\n"
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write allowlist: %v", err)
+	}
+	allowlist, err := loadAllowlist(path)
+	if err != nil {
+		t.Fatalf("failed to load allowlist: %v", err)
+	}
+	if len(allowlist) != 1 || !allowlist[allowed] {
+		t.Fatalf("allowlist mismatch: have %v", allowlist)
+	}
+
+	_, _, address, err := authAllowlist("please fund "+allowed.Hex(), allowlist)
+	if err != nil {
+		t.Fatalf("allowed address rejected: %v", err)
+	}
+	if address != allowed {
+		t.Errorf("address mismatch: have %x, want %x", address, allowed)
+	}
+	if _, _, _, err := authAllowlist("0x2000000000000000000000000000000000000002", allowlist); err == nil {
+		t.Errorf("address not in the allowlist accepted")
+	}
+	if _, _, _, err := authAllowlist("no address", allowlist); err == nil {
+		t.Errorf("request without address accepted")
+	}
+
+	if err := ioutil.WriteFile(path, []byte("0x1234\n"), 0600); err != nil {
+		t.Fatalf("failed to write allowlist: %v", err)
+	}
+	if _, err := loadAllowlist(path); err == nil {
+		t.Errorf("invalid address loaded")
+	}
+}
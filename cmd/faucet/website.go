@@ -67,7 +67,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _faucetHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x71\x73\xdb\xb6\x92\xff\xdb\xf9\x14\x5b\x5e\xfc\x24\x9d\x4d\x52\xb6\x93\x3c\x9f\x44\xaa\x93\x97\xd7\xd7\xcb\xcd\x5d\xdb\x69\xd3\xb9\x7b\xd3\xd7\xb9\x01\x89\x95\x88\x18\x04\x58\x00\x94\xac\x7a\xf4\xdd\x6f\x00\x90\x14\x29\xc9\x6e\xd2\xe4\xde\x34\x7f\x38\x24\xb0\xd8\x5d\xec\xfe\x16\xbb\x58\x2a\xf9\xe2\xaf\xdf\xbe\x79\xf7\xf7\xef\xbe\x82\xc2\x94\x7c\xf1\x2c\xb1\xff\x01\x27\x62\x95\x06\x28\x82\xc5\xb3\xb3\xa4\x40\x42\x17\xcf\xce\xce\x92\x12\x0d\x81\xbc\x20\x4a\xa3\x49\x83\xda\x2c\xc3\xdb\x60\x3f\x51\x18\x53\x85\xf8\x4b\xcd\xd6\x69\xf0\x3f\xe1\x8f\xaf\xc3\x37\xb2\xac\x88\x61\x19\xc7\x00\x72\x29\x0c\x0a\x93\x06\x6f\xbf\x4a\x91\xae\xb0\xb7\x4e\x90\x12\xd3\x60\xcd\x70\x53\x49\x65\x7a\xa4\x1b\x46\x4d\x91\x52\x5c\xb3\x1c\x43\xf7\x72\x09\x4c\x30\xc3\x08\x0f\x75\x4e\x38\xa6\x57\xc1\xe2\x99\xe5\x63\x98\xe1\xb8\x78\x78\x88\xbe\x41\xb3\x91\xea\x6e\xb7\x9b\xc1\xeb\xda\x14\x28\x0c\xcb\x89\x41\x0a\x7f\x23\x75\x8e\x26\x89\x3d\xa5\x5b\xc4\x99\xb8\x83\x42\xe1\x32\x0d\xac\xea\x7a\x16\xc7\x39\x15\xef\x75\x94\x73\x59\xd3\x25\x27\x0a\xa3\x5c\x96\x31\x79\x4f\xee\x63\xce\x32\x1d\x9b\x0d\x33\x06\x55\x98\x49\x69\xb4\x51\xa4\x8a\x6f\xa2\x9b\xe8\xcf\x71\xae\x75\xdc\x8d\x45\x25\x13\x51\xae\x75\x00\x0a\x79\x1a\x68\xb3\xe5\xa8\x0b\x44\x13\x40\xbc\xf8\x7d\x72\x97\x52\x98\x90\x6c\x50\xcb\x12\xe3\x17\xd1\x9f\xa3\xa9\x13\xd9\x1f\x7e\x5a\xaa\x15\xab\x73\xc5\x2a\x03\x5a\xe5\x1f\x2c\xf7\xfd\x2f\x35\xaa\x6d\x7c\x13\x5d\x45\x57\xcd\x8b\x93\xf3\x5e\x07\x8b\x24\xf6\x0c\x17\x9f\xc4\x3b\x14\xd2\x6c\xe3\xeb\xe8\x45\x74\x15\x57\x24\xbf\x23\x2b\xa4\xad\x24\x3b\x15\xb5\x83\x9f\x4d\xee\x63\x3e\x7c\x7f\xe8\xc2\xcf\x21\xac\x94\x25\x0a\x13\xbd\xd7\xf1\x75\x74\x75\x1b\x4d\xdb\x81\x63\xfe\x4e\x80\x75\x9a\x15\x75\x16\xad\x51\x59\xe4\xf2\x30\x47\x61\x50\xc1\x83\x1d\x3d\x2b\x99\x08\x0b\x64\xab\xc2\xcc\xe0\x6a\x3a\x3d\x9f\x9f\x1a\x5d\x17\x7e\x98\x32\x5d\x71\xb2\x9d\xc1\x92\xe3\xbd\x1f\x22\x9c\xad\x44\xc8\x0c\x96\x7a\x06\x9e\xb3\x9b\xd8\x39\x99\x95\x92\x2b\x85\x5a\x37\xc2\x2a\xa9\x99\x61\x52\xcc\x2c\xa2\x88\x61\x6b\x3c\x45\xab\x2b\x22\x8e\x16\x90\x4c\x4b\x5e\x1b\x3c\x50\x24\xe3\x32\xbf\xf3\x63\x2e\x9a\xfb\x9b\xc8\x25\x97\x6a\x06\x9b\x82\x35\xcb\xc0\x09\x82\x4a\x61\xc3\x1e\x2a\x42\x29\x13\xab\x19\xbc\xaa\x9a\xfd\x40\x49\xd4\x8a\x89\x19\x4c\xf7\x4b\x92\xb8\x35\x63\x12\xfb\x83\xeb\xd9\x59\x92\x49\xba\x75\x3e\xa4\x6c\x0d\x39\x27\x5a\xa7\xc1\x81\x89\xdd\x81\x34\x20\xb0\xe7\x10\x61\xa2\x9d\x1a\xcc\x29\xb9\x09\xc0\x09\x4a\x03\xaf\x44\x98\x49\x63\x64\x39\x83\x2b\xab\x5e\xb3\xe4\x80\x1f\x0f\xf9\x2a\xbc\xba\x6e\x27\xcf\x92\xe2\xaa\x65\x62\xf0\xde\x84\xce\x3f\x9d\x67\x82\x45\xc2\xda\xb5\x4b\x02\x4b\x12\x66\xc4\x14\x01\x10\xc5\x48\x58\x30\x4a\x51\xa4\x81\x51\x35\x5a\x1c\xb1\x05\xf4\x8f\xbf\x47\x4e\xbf\xe2\xaa\xd5\x2b\xa6\x6c\xdd\x6c\xab\xf7\x78\xb0\xc3\xc7\x37\x71\x0b\xcd\x83\x5c\x2e\x35\x9a\xb0\xb7\xa7\x1e\x31\x13\x55\x6d\xc2\x95\x92\x75\xd5\xcd\x9f\x25\x6e\x14\x18\x4d\x83\x5a\xf1\xa0\x39\xfe\xdd\xa3\xd9\x56\x8d\x29\x82\x6e\xe3\x52\x95\xa1\xf5\x84\x92\x3c\x80\x8a\x93\x1c\x0b\xc9\x29\xaa\x34\xf8\x41\xe6\x8c\x70\x10\x7e\xcf\xf0\xe3\xf7\xff\x09\x8d\xcb\x98\x58\xc1\x56\xd6\x0a\xbe\x32\x05\x2a\xac\x4b\x20\x94\x5a\xb8\x46\x51\xd4\x53\xc4\x61\xf7\x58\xd5\x30\x33\x62\x4f\x75\x96\x64\xb5\x31\xb2\x23\xcc\x8c\x80\xcc\x88\x90\xe2\x92\xd4\xdc\x00\x55\xb2\xa2\x72\x23\x42\x23\x57\x2b\x9b\xe9\xfc\x26\xfc\xa2\x00\x28\x31\xa4\x99\x4a\x83\x96\xb6\xf5\x21\xd1\x95\xac\xea\xaa\xf1\xa2\x1f\xc4\xfb\x8a\x08\x8a\xd4\xfa\x9c\x6b\x0c\x16\x5f\xb3\x35\x42\x89\x7e\x2f\x67\x87\x90\xc8\x89\x42\x13\xf6\x99\x1e\x01\x23\x89\xbd\x32\x7e\x4b\xd0\xfc\x4b\x6a\xde\x72\xea\xb6\x50\xa2\xa8\x61\xf0\x16\x2a\x7b\xae\x04\x8b\x87\x07\x45\xc4\x0a\xe1\x39\xa3\xf7\x97\xf0\x9c\x94\xb2\x16\x06\x66\x29\x44\xaf\xdd\xa3\xde\xed\x06\xdc\x01\x12\xce\x16\x09\x79\x0a\xde\x20\x45\xce\x59\x7e\x97\x06\x86\xa1\x4a\x1f\x1e\x2c\xf3\xdd\x6e\x0e\x0f\x0f\x6c\x09\xcf\xa3\xef\x31\x27\x95\xc9\x0b\xb2\xdb\xad\x54\xfb\x1c\xe1\x3d\xe6\xb5\xc1\xf1\xe4\xe1\x01\xb9\xc6\xdd\x4e\xd7\x59\xc9\xcc\xb8\x5d\x6e\xc7\x05\xdd\xed\xac\xce\x8d\x9e\xbb\x1d\xc4\x96\xa9\xa0\x78\x0f\xcf\xa3\xef\x50\x31\x49\x35\x78\xfa\x24\x26\x8b\x24\xe6\x6c\xd1\xac\x1b\x1a\x29\xae\xf9\x1e\x2f\xb1\x05\x4c\x87\x73\x17\x36\x4e\xd5\xbe\xa6\x27\xa2\x60\x15\x76\xda\x37\x78\xd0\xcc\xe0\x1d\x6e\xd3\xe0\xe1\xa1\xbf\xb6\x99\xcd\x09\xe7\x19\xb1\x76\xf1\x5b\xeb\x16\xfd\x8a\x16\xa7\x6b\xa6\x5d\x49\xb5\x68\x35\xd8\xab\xfd\x81\x61\x7d\x70\x70\x19\x59\xcd\xe0\xe6\xba\x77\x6a\x9d\x8a\xf8\x57\x07\x11\x7f\x73\x92\xb8\x22\x02\x39\xb8\xbf\xa1\x2e\x09\x6f\x9f\x9b\x68\xe9\x05\xdf\xe1\xa2\xd0\x9e\xd1\x9d\x6a\xdd\x59\x3f\x9d\x83\x5c\xa3\x5a\x72\xb9\x99\x01\xa9\x8d\x9c\x43\x49\xee\xbb\x7c\x77\x33\x9d\xf6\xf5\xb6\xa5\x20\xc9\x38\xba\xd3\x45\xe1\x2f\x35\x6a\xa3\xbb\xb3\xc4\x4f\xb9\xbf\xf6\x48\xa1\x28\x34\xd2\x03\x6b\x58\x89\xd6\xb4\x8e\xaa\xe7\xfa\xce\x98\x27\x75\x5f\x4a\xd9\xa5\x90\xbe\x1a\x0d\xeb\x5e\xb6\x0b\x16\x89\x51\x7b\xba\xb3\xc4\xd0\x8f\x4a\x01\xca\x96\x78\x8f\x65\x00\x7f\xa2\xd9\xbd\x57\x88\xca\xd7\x17\x16\xb2\xe0\x5e\x93\xd8\xd0\x4f\x90\x6c\x41\x98\x11\x8d\x1f\x22\xde\x65\xfa\xbd\x78\xf7\xfa\xa9\xf2\x0b\x24\xca\x64\x48\xcc\x87\x28\xb0\xac\x05\xed\xed\xdf\x9d\x9d\x9f\xaa\x40\x2d\xd8\x1a\x95\x66\x66\xfb\xa1\x1a\x20\xdd\xab\xe0\xdf\x87\x2a\x24\xb1\x51\x4f\x63\xad\xff\xf2\x99\x82\xfb\xb7\x4a\x92\x9b\xc5\xbf\xcb\x0d\x50\x89\x1a\x4c\xc1\x34\xd8\xe4\xfa\x65\x12\x17\x37\x1d\x49\xb5\x78\x67\x27\x9c\x51\x61\xe9\x4a\x0b\x60\x1a\x54\x2d\x5c\xe6\x95\x02\x4c\x81\xc3\x72\xa4\x49\xd2\x11\xbc\x93\xb6\xa4\x5b\xa3\x30\x50\x12\xce\x72\x26\x6b\x0d\x24\x37\x52\x69\x58\x2a\x59\x02\xde\x17\xa4\xd6\xc6\x32\xb2\xc7\x07\x59\x13\xc6\x5d\x2c\x39\x97\x82\x54\x40\xf2\xbc\x2e\x6b\x5b\x92\x8a\x15\xa0\x90\xf5\xaa\x68\x74\x31\x12\x7c\x62\xe2\x52\xac\x3a\x7d\x74\x45\x4a\x20\xc6\x90\xfc\x4e\x5f\x42\x7b\x2a\x00\x51\x08\x86\x21\xb5\xab\x72\x59\x96\x52\xc0\x8d\xa2\x50\x11\x65\xb6\xa0\x87\xb5\x05\xc9\x73\x97\xe5\x22\x78\x2d\xb6\x52\x20\x14\x64\xed\x34\x84\x77\xfe\x3a\x71\x09\x5f\x4b\xb9\xe2\x78\x61\x15\xfc\x1b\xc9\x31\x93\xb2\x5b\x06\x25\xd9\xb6\x72\x9b\x6d\x6c\x98\x29\x98\xb7\x53\x85\xaa\xb4\x3c\x28\x70\x56\x32\xa3\xa3\x24\xae\xf6\x47\xeb\x3e\x49\xf3\xb0\x90\x8a\xfd\x6a\x2b\x1c\xde\x3f\x47\xcd\xc1\x29\xd3\x1e\x92\xce\xfd\x1c\x97\x66\x06\x2f\xfc\x21\x79\x08\xe8\xe6\x2a\x74\x0a\xcd\x2d\x4f\x77\xc5\xb4\x99\x67\x06\x37\xbe\xae\xf5\x15\x05\x35\x3d\x0d\xe8\x01\xe6\xbc\xd0\xdb\xdb\xea\xbe\xd3\xa3\x2b\x8e\xa7\x1d\x13\x0b\x85\xa1\x51\xd6\xac\x67\xcf\x92\xdc\x21\x10\x48\xc8\xc1\x55\xb9\x51\xda\x5d\xb4\x98\x6b\x14\xc4\x66\x83\x68\xbe\xb4\x31\x9c\x7e\xef\x19\x32\xb1\x3a\xbf\x9e\x7a\x68\xda\x07\xcb\xfe\xfc\x7a\xca\x84\x91\xe7\xd7\xd3\xe9\xfd\xf4\x03\xff\x9d\x5f\x4f\xa5\x38\xbf\x9e\x9a\x02\xcf\xaf\xa7\xe7\xd7\x37\x7d\x50\xfb\x91\xb6\xc4\xb4\x54\xa8\xad\xb4\x16\xeb\x01\x18\xa2\x56\x68\xd2\xe0\x7f\x49\x26\x6b\x33\xcb\x38\x11\x77\xc1\xc2\xa9\x6b\xcb\x0e\x87\x82\xd3\x85\x2a\x54\x44\x5b\x48\x58\x8d\x1d\x4a\x9a\xa6\x88\x86\xb1\xae\x95\x92\xb5\xb0\xe9\x11\xec\x9e\x5d\xa8\x8a\x91\x45\x99\x35\xcc\x24\x4a\x32\x15\x2f\xde\xc8\x6a\x1b\x3a\x26\x6e\xf9\x91\x19\x75\x5d\x55\x52\x99\xa8\x6f\x4e\x62\x2f\x44\x1c\x75\x7c\x3b\x7d\x79\xfb\xea\x49\xf5\xb5\x2d\xb7\xdd\x1e\x3a\x0d\x49\x26\xd7\x08\xbe\xb8\xcf\xe4\x3d\x10\x41\x61\xc9\x14\x02\xd9\x90\xed\x17\x49\x4c\xdd\x55\xec\xd3\x51\xbb\x72\x81\x16\x56\xbc\xd6\xb6\x16\x61\x36\x50\xff\x50\x10\xf6\x27\x01\x7c\xc7\x6b\x7d\x09\x55\x9d\x71\xa6\x0b\x20\x20\x70\x03\x89\x36\x4a\x8a\xd5\xc2\x8d\xe6\xf6\xaa\xea\x5e\xa1\x92\xda\x3c\x85\x06\x2c\x33\xa4\xf4\x04\x1e\x7e\x27\x1c\xac\x3c\xe7\xc2\x7f\xbe\xfb\x96\xcd\xe1\xf8\x87\x72\x59\x7b\x62\xff\x51\xfd\x75\x14\xbe\x9b\xcd\x26\x6a\x2d\xe9\x62\xb7\x40\x5e\xc5\x36\x8d\xd5\x82\x99\x6d\xec\x4f\x41\x29\xe2\x2f\x19\x4d\xaf\x6f\xaf\x5f\xbd\xba\x7e\xf1\x6f\xb7\x2f\x5f\x5e\xdf\xbe\x78\xf9\x58\x60\x77\xa0\xf8\xfd\x71\xed\xaf\x43\xdf\xc8\xd7\xb5\x29\xba\xbb\x90\xc7\x4b\x5b\x83\xdb\x4a\x8b\xda\xbb\xa4\x0a\x7e\x37\x86\x6a\x61\x0b\xca\x90\xf0\x93\xb5\xe0\x47\xa0\xc8\xc1\xe8\x09\xcd\x3e\x11\x5a\x2d\x7c\x2c\x52\x64\x6d\xec\x0e\xdb\xa6\x0c\x93\xa2\x83\xd3\x25\x68\x56\x56\x7c\x0b\xf9\xde\xeb\xa7\x71\xf5\xa8\x53\x7e\x13\x56\x43\xb7\x79\x90\xb9\x2a\xae\x94\x14\x6d\xf5\xa6\x6b\x9d\x63\xe5\xba\xf5\xb6\x22\xfa\xcb\xf6\x57\x22\x0c\x13\xd8\x56\x4e\x11\x7c\x2b\xf8\x16\x6a\x8d\xb0\x94\x0a\x28\x66\xf5\x6a\xe5\xca\x3d\x05\x95\x62\x6b\x62\xb0\x2d\x97\x74\x83\x8a\x0e\x14\xbd\x1b\xaa\x2d\x5d\x79\xaf\x92\xfc\xbb\xac\x21\x27\x02\x8c\x22\xf9\x9d\x8f\x94\x5a\x29\x1b\x29\x15\xfa\xdd\x74\x05\x5b\x86\x5c\x6e\x1c\x89\xdf\xf7\x92\x21\x77\xd5\x9b\x46\x84\x42\x6e\xa0\xac\x73\x17\x90\xb6\x3a\x73\x9b\xd8\x10\x66\xa0\x16\x86\x71\x6f\x4f\x53\x2b\x61\x6b\x3d\x1c\x14\x59\x47\x77\xf8\x04\xcb\xc5\xbb\x02\x4f\x94\xb6\xdd\xed\x1b\x14\xbe\xf1\xe4\x50\x29\x69\x30\xb7\x0e\x05\xb2\x22\x4c\x68\xeb\x11\x57\xc6\x61\xf9\x01\xb7\xf3\xee\xa9\x79\xd8\x77\x9a\xdd\x74\x1c\xc3\xd7\x5c\x66\x84\xc3\xda\x22\x3d\xe3\xb6\x2c\x97\x50\x48\xbb\xf5\x9e\xb5\xb4\x21\xa6\xd6\x20\x97\x6e\xd4\x6b\x6e\xd7\xaf\x89\xb2\x1e\xc4\xb2\x32\x90\x36\x7d\x52\x3b\xa6\x51\xad\x9b\xee\xaf\x7d\x35\x0c\xd5\x60\xbe\xb3\x7a\x0a\x3f\xfd\x3c\x7f\xd6\xa8\xf2\x57\x5c\x3a\x48\x58\x7c\xfb\x2d\x9b\x82\x18\xc8\x15\x12\x83\x1a\x72\x2e\x75\xad\xbc\x86\x54\xc9\x0a\xac\x96\x2d\xa7\x96\xb3\x9d\xa8\x9c\xb4\x96\xc9\xb8\x20\xba\x98\x34\x6d\x5e\x85\xce\x4b\xdd\x5c\x3b\x7e\x66\x51\x37\xb6\x0c\x58\x3a\x9d\x03\x4b\x5a\xbe\x11\x47\xb1\x32\xc5\x1c\xd8\xc5\x45\x47\x7c\xc6\x96\x30\x6e\x29\x7e\x62\x3f\x47\xe6\x3e\xb2\x52\x20\x4d\xa1\x2f\xcd\x09\x6c\xf8\xe8\x8a\xb3\x1c\xc7\xec\x12\xae\x26\xf3\x76\x36\x53\x48\xee\xda\xb7\xc6\x8f\xfe\x3f\xf7\x77\x37\x1f\x5a\xc6\x19\x7f\x60\x1b\xdf\xc3\xd1\x40\x60\xc5\xb4\x81\x5a\x71\x68\x62\xd8\xbb\xa0\x73\x88\xa3\xeb\x5b\xe5\x08\x97\xcd\x43\x83\xa9\x76\x0b\x9e\x4d\xa4\x51\xd0\xf1\x7f\xfc\xf0\xed\x37\x91\x36\x8a\x89\x15\x5b\x6e\xc7\x0f\xb5\xe2\x33\x78\x3e\x0e\xfe\xa5\x56\x3c\x98\xfc\x34\xfd\x39\x5a\x13\x5e\xe3\xa5\xf3\xf7\xcc\xfd\x3d\x92\x72\x09\xcd\xe3\x0c\x86\x02\x77\x93\xc9\xfc\x74\xbf\xab\xd7\x9e\x53\xa8\xd1\x8c\x2d\x61\x07\xfc\x43\x1b\x11\x28\xd1\x14\xd2\x85\xae\xc2\x5c\x0a\x81\xb9\x81\xba\x92\xa2\x31\x09\x70\xa9\xf5\x1e\x88\x2d\x45\x7a\x0c\x8a\x86\x3e\x75\xc9\xfa\xbf\x31\xfb\x41\xe6\x77\x68\xc6\xe3\xf1\x86\x09\x2a\x37\x11\x97\xfe\xa8\x8d\x6c\x90\xca\x5c\x72\x48\xd3\x14\x9a\x2c\x1a\x4c\xe0\x4b\x08\x36\xda\xe6\xd3\x00\x66\xf6\xd1\x3e\x4d\xe0\x02\x0e\x97\x17\x36\xdf\x5f\x40\x10\x93\x8a\x05\x13\x1f\x0e\xad\xe1\xa5\x28\x51\x6b\xb2\xc2\xbe\x82\xee\x86\xdb\x81\xcc\xee\xa3\xd4\x2b\x48\xc1\x39\xa8\x22\x4a\xa3\x27\x89\x28\x31\xa4\x45\x9b\xc5\xac\x23\x4b\x53\x10\x35\xe7\x7b\x90\xfa\xa0\x98\xb7\xf0\x1b\x90\x47\x3e\xd7\x7c\x91\xa6\x50\x0b\xea\x4c\x4c\xf7\x2b\xad\xf3\x7d\x33\x64\x12\xd9\xbc\xb0\x5f\x31\x99\xf7\xd1\x3c\xe0\x86\xf4\xb7\xd8\x21\x3d\xe4\x87\xf4\x11\x86\xae\xf7\xf4\x14\x3f\xdf\xab\xea\xb1\x73\x03\x8f\x70\x13\x75\x99\xa1\x7a\x8a\x9d\xef\x3d\x35\xec\x9c\xa9\xdf\x0a\xd3\x5b\x7b\x09\x57\xaf\x26\x8f\x70\x47\xa5\xe4\xa3\xcc\x85\x34\xdb\xf1\x03\x27\x5b\x5b\x33\xc1\xc8\xc8\xea\x8d\x6b\x15\x8d\x2e\x5d\xc6\x9d\x41\xc7\xe1\xd2\x7d\x04\x98\xc1\xc8\xbd\xd9\x79\x56\xa2\x5b\xf5\x72\x3a\x9d\x5e\x42\xfb\xf5\xec\x2f\xc4\x06\xa1\xaa\x71\xf7\x88\x3e\xba\xce\x73\x9b\xf7\x3f\x45\xa3\x86\x47\xa7\x53\xf3\xfe\x09\x5a\x75\xb9\x61\xa0\x16\xfc\xe9\x4f\x70\x34\x3b\x84\x71\x1c\xc3\x7f\x11\x75\xe7\x1a\x3b\x95\xc2\xb5\x6b\xfe\x74\xf4\x25\xd3\xda\x35\x55\x34\x50\x29\xb0\x59\xf3\x71\xc7\xfe\x91\x8e\x0d\x19\x2c\x60\x7a\xa8\xa0\x3d\x0e\x7b\x69\xe1\x44\xb6\xe8\xf1\x1d\x26\x82\xb3\x5d\x5f\xde\x60\x25\x2b\x11\xbe\x48\x21\x08\xfa\x8b\x8f\x28\x2c\x41\xc7\xec\x4c\xa3\x79\xe7\x7d\x31\x6e\xb2\xe3\xa9\xdc\x35\xb9\x84\x9b\xe9\x74\x3a\x39\x52\x62\xb7\x37\xef\xeb\xca\x96\x4d\x40\xc4\xd6\x1d\x89\x9d\x6d\x5d\xe1\x68\x4b\x20\x7b\xa4\x71\xc8\x25\xe7\xbe\x66\x69\x96\x5a\x03\x37\x4d\xb0\x14\xc2\xab\xf9\x89\x2c\xda\xb3\x64\x6f\x6b\x87\xee\x39\x61\xfb\x43\x17\x0d\x6d\x76\x40\x1c\x5e\x0d\x9c\x32\xf0\xd7\x69\xc7\x9c\x75\x7a\xb3\xbd\x45\x0f\xdc\xb5\xf7\xd7\xa1\xcd\x7a\xfa\x7b\x3e\x17\x57\x1f\xb8\x8d\x6e\xba\xaa\x75\x31\x3e\x50\x74\x32\x3f\xf6\xcd\x5b\x83\xca\x56\xc9\xd2\xa6\x2c\xeb\x0b\x7b\x15\x50\x78\xe4\x12\x57\xaa\x2b\x0c\x15\x0a\x8a\xaa\x2d\x29\x7c\x65\x6f\x0b\xc0\x81\xcb\xfc\xad\xb2\x0f\xa7\x8f\x0c\x18\x57\x92\x49\x81\x00\x00\x07\x41\xe0\x80\x3a\x40\xaa\x25\x46\x4e\x2a\x8d\x14\x52\xf0\x3f\x66\x18\x4f\xa2\x5a\xb0\xfb\xf1\x24\x6c\xde\x0f\x79\xb4\xf3\xf3\xee\x9a\xd8\xaa\x7d\x91\x42\x90\x18\x05\x8c\xa6\xa3\x00\x2e\x4e\x85\xa0\xcd\xba\xa3\xc5\x5e\x83\xfe\x52\x80\xc4\xd0\x85\xeb\x67\xfb\xfb\xda\x3f\x82\x8c\xe4\x77\x2b\x77\x11\x9a\xd9\x52\x6b\x7c\xc4\x96\xac\x89\x21\xca\x71\x9d\xcc\x61\x4f\xde\x5c\x14\x73\xeb\x9c\x39\xf8\x1b\xa9\x6b\x9b\x43\xf7\xa9\xc9\xbd\x65\x52\x51\x54\xa1\x22\x94\xd5\x7a\x06\x2f\xaa\xfb\xf9\x3f\xda\x4f\x71\xae\xb9\xff\xa4\xaa\x95\xc2\xc5\x91\x46\x4d\x93\xf8\x02\x82\x24\xb6\x04\xbf\xc5\xa6\xdb\x6c\xff\x47\x14\x70\xe2\x13\x06\x74\x3f\x71\x68\xc6\x4b\x46\x29\x47\xab\xf0\x9e\xbd\x0d\x46\xeb\xff\x7e\x48\x0d\x45\x42\xf3\xed\x62\xbf\x66\x07\xc8\x35\x3e\xb1\xa0\xfb\x0c\x32\xb2\x00\x08\xed\x96\x99\xb3\x79\x73\xd9\x76\xc3\x6a\xe4\x6c\xd1\xfc\x24\x86\xd6\xca\xd5\x5a\xe3\xb0\x01\xd8\x25\x8c\xb4\xad\xfd\xa8\x1e\x4d\xa2\xa2\x2e\x89\x60\xbf\xe2\xd8\xe6\xa5\x89\xb7\x95\xfb\xae\x12\x1c\x1f\xc9\x47\xca\xec\x3f\x78\x8c\xda\x1c\x37\x6a\x8c\x38\x6a\xbd\xfb\x62\x7f\xb7\x9f\xc1\x74\x3e\xfa\x48\x0b\x9d\x96\x12\x66\x44\x41\xff\x25\x6c\x93\x2f\x28\x69\xa5\xb7\x73\x19\x51\x23\xdf\xc9\x70\xf5\xb9\x90\x9b\x74\x74\x33\xed\x94\xf4\x8e\x76\x7e\x1e\x35\x58\x3b\x72\x86\xd5\xb2\x0d\xcd\x05\xdc\x4c\x3f\x87\xb6\xbe\x1b\x72\xb0\x03\xa3\x58\x85\x14\x48\x6e\xd8\x1a\xff\x1f\x36\xf2\x19\x8c\xfc\xd1\x2a\x5a\x1c\xb6\xc6\x73\x30\x1d\xe8\x6b\x67\x3b\xdb\xfe\xab\x8d\x37\x88\x9d\x85\x2f\x20\x38\xb9\x91\x47\x91\x78\x40\x78\x10\xda\x8f\xc7\xbd\xfb\x50\x18\x1c\xe6\x14\x5b\xed\x76\x1f\xb9\x27\x51\x61\x4a\x3e\x0e\x12\xe3\x7e\xec\x64\x75\xee\x38\x38\x06\x7e\x78\x58\xd2\xed\x86\x17\x19\x7b\x7f\xc7\x83\x7b\x16\xf4\x8a\x93\xee\x2e\xd6\x56\x22\xb0\xdb\xff\x26\x2c\x8e\xe1\x07\x43\x94\x01\x02\x3f\xbe\x85\xba\xa2\xc4\xf8\x4f\x72\x36\x3f\xfa\xae\x73\xfb\xa3\xb1\x8c\x28\x0d\x4b\xa9\x36\x44\xd1\xa6\x3f\x63\x0a\xdc\xba\x4f\x72\x6d\xe9\xa7\xd1\xbc\xb5\xa7\xd8\x9a\xf0\xf1\xd1\xbd\xef\xf9\x78\x14\xf5\x5d\x3e\x9a\x44\x48\xf2\xe2\x98\xd0\x65\xac\x4e\x6e\x0a\xdf\xb8\x2b\xc0\xf8\xf9\xd8\x14\x4c\x4f\x22\x62\x8c\x1a\x8f\x06\x60\x18\x4d\xac\x5f\xaf\x7a\x57\xb2\x6e\x79\x32\x08\xab\xa7\x78\xec\x8b\xe9\xae\x10\x68\xc9\x73\xad\xc7\x1e\x57\xa3\xcb\x1e\xef\x21\xac\x46\xe7\xa3\xce\x51\xfb\xf0\xde\xef\x23\x3d\xa9\xc9\x80\xf5\xc8\x46\xd9\xe8\x48\x3c\xa1\xf4\x8d\x8d\x9f\x71\x70\x22\xd2\x0f\xd1\x31\xe9\x8c\xed\xcf\xeb\x27\xad\xec\x7f\x5e\xf3\x88\x89\x19\x1d\x4d\x22\x5d\x67\xbe\x37\x31\x7e\xd9\x5d\xc0\x5a\x32\x07\xde\xc3\x54\x70\x54\x50\x58\x11\xc3\xa2\x22\x3c\x28\x42\x9e\xc8\x1a\x8d\x48\xbf\xab\xdd\xa5\x35\xf8\x74\xd2\xb5\xb6\xbe\xd2\xb6\xb8\xf2\xad\xff\x0d\x66\xda\x75\x12\xa0\xc1\xbb\xeb\xe6\xf8\xae\xcd\xeb\xef\xde\xf6\x3a\x37\x5d\x44\x8c\x1d\xf7\xee\xf7\x9c\xa7\xfa\x24\x27\x7f\x40\xba\xd9\x6c\x22\xff\x45\xcb\xb5\xf1\xbb\x46\x4a\x4c\x2a\x16\xbd\xd7\x01\x10\xbd\x15\x39\x50\x5c\xa2\x5a\xf4\xd8\x37\xdd\x95\x24\xf6\x3f\x6d\x4c\x62\xff\xeb\xed\xff\x0b\x00\x00\xff\xff\x56\xf8\xb5\xef\xce\x2d\x00\x00")
+var _faucetHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x3b\xfd\x73\xdb\x36\xb2\x3f\xbb\x7f\x05\xca\x4b\x4f\xd2\xc5\xa4\x64\x3b\xc9\xf9\xc9\x92\x32\xb9\xb4\xd7\x97\x77\x77\x49\xa7\x49\xe7\xda\xe9\x75\xde\x40\x24\x24\x21\x21\x09\x1e\x00\x4a\x56\x3d\xfa\xdf\x6f\x17\x00\xc1\x0f\x49\xae\x1d\xfb\xbd\xa9\x67\x2c\x91\xc4\x62\x77\xb1\x5f\xd8\x5d\x50\x93\x2f\xbf\x7e\xf7\xfa\xc3\x4f\xdf\x7d\x43\x56\x3a\x4b\x67\x5f\x4c\xf0\x8b\xa4\x34\x5f\x4e\x03\x96\x07\xb3\x2f\x4e\x26\x2b\x46\x13\xf8\x3e\x99\x64\x4c\x53\x12\xaf\xa8\x54\x4c\x4f\x83\x52\x2f\xc2\xcb\xa0\x1e\x58\x69\x5d\x84\xec\xdf\x25\x5f\x4f\x83\x1f\xc3\x1f\x5e\x85\xaf\x45\x56\x50\xcd\xe7\x29\x0b\x48\x2c\x72\xcd\x72\x98\xf5\xe6\x9b\x29\x4b\x96\xac\x31\x2f\xa7\x19\x9b\x06\x6b\xce\x36\x85\x90\xba\x01\xba\xe1\x89\x5e\x4d\x13\xb6\xe6\x31\x0b\xcd\xcd\x29\xe1\x39\xd7\x9c\xa6\xa1\x8a\x69\xca\xa6\x67\x80\x06\xf1\x68\xae\x53\x36\xbb\xb9\x89\xde\x32\xbd\x11\xf2\xd3\x6e\x37\x26\xaf\x4a\xbd\x02\x34\x3c\xa6\x9a\x25\xe4\xaf\xb4\x8c\x99\x9e\x0c\x2d\xa4\x99\x94\xf2\xfc\x13\x59\x49\xb6\x98\x06\xc8\xba\x1a\x0f\x87\x71\x92\x7f\x54\x51\x9c\x8a\x32\x59\xa4\x54\xb2\x28\x16\xd9\x90\x7e\xa4\xd7\xc3\x94\xcf\xd5\x50\x6f\xb8\xd6\x4c\x86\x73\x21\xb4\xd2\x92\x16\xc3\x8b\xe8\x22\xfa\xf3\x30\x56\x6a\xe8\x9f\x45\x19\xcf\x23\x78\x12\x10\xc9\xd2\x69\xa0\xf4\x36\x65\x6a\xc5\x18\xac\x6c\x38\xfb\x3c\xba\x0b\x90\x48\x48\x37\x4c\x89\x8c\x0d\x9f\x45\x7f\x8e\x46\x86\x64\xf3\xf1\xed\x54\x91\xac\x8a\x25\x2f\x34\x51\x32\xbe\x33\xdd\x8f\xff\x2e\x99\xdc\xc2\x22\xcf\xa2\x33\x77\x63\xe8\x7c\x54\xc1\x6c\x32\xb4\x08\x67\x0f\xc2\x1d\xe6\x42\x6f\x87\xe7\xd1\x33\x20\x50\xd0\xf8\x13\x5d\xb2\xa4\xa2\x84\x43\x51\xf5\xf0\xd1\xe8\x1e\xd3\xe1\xc7\xae\x0a\x1f\x83\x58\x06\x9a\xc9\x35\xa0\x82\x25\x9e\x5d\x82\xda\xdc\x83\x7d\xfc\x86\x00\x2a\x0d\x49\x9d\x44\x6b\x26\xd1\x72\xd3\x30\x06\x70\x26\xc9\x0d\x3e\x3d\x81\x69\xe1\x8a\xf1\xe5\x4a\x8f\xc9\xd9\x68\xf4\xd5\xd5\xa1\xa7\xeb\x95\x7d\x9c\x70\x55\xa4\x74\x3b\x26\x8b\x94\x5d\xdb\x47\x34\xe5\xcb\x3c\xe4\x9a\x65\x6a\x4c\x2c\x66\x33\xb0\x33\x34\x0b\x29\x96\x92\x29\xe5\x88\x15\x42\x81\xab\x89\x7c\x8c\x16\x05\x6e\xbc\x66\x87\x60\x55\x41\xf3\xbd\x09\x74\xae\x44\x5a\x6a\xd6\x61\x64\x9e\x8a\xf8\x93\x7d\x66\xbc\xb9\xb9\x88\x58\xa4\x42\x8e\xc9\x66\xc5\xdd\x34\x62\x08\x91\x42\x32\x87\x9e\x14\x34\x49\x78\xbe\x1c\x93\x17\x85\x5b\x0f\xc9\xa8\x5c\x72\x20\x38\xaa\xa7\x80\x48\x9d\x18\x27\x43\x1b\xb8\xe0\x6a\x2e\x92\xad\xd1\x61\xc2\xd7\x24\x4e\xa9\x52\x10\x70\xda\x22\x36\x01\xa9\x05\x80\x71\x88\xf2\xbc\x1a\x6a\x8d\x49\xb1\x09\x88\x21\x34\x0d\x2c\x13\x60\x50\x5a\x8b\x0c\xd6\x84\xec\xb9\x29\x1d\x7c\x69\x98\x2e\xc3\xb3\xf3\x6a\x10\x22\xeb\x59\x85\x44\xb3\x6b\xf0\x65\xd4\x8f\xd7\x0c\x98\x07\xaf\xe6\x2e\x28\x59\xd0\x70\x4e\xf5\x2a\x20\x54\x72\x1a\xae\x78\x92\xb0\x1c\xe6\xc9\x92\xa1\x1d\xf1\x19\x69\x86\xbf\x23\xd1\x6f\x75\x56\xf1\x35\x04\xc6\xdc\xb2\x1a\x97\x9d\x15\x1e\x5f\xc4\x25\x71\x17\x62\xb1\x80\xcd\x20\x6c\xac\xa9\x01\xcc\xf3\xa2\xd4\xe1\x52\x8a\xb2\xf0\xe3\x27\x13\xf3\x94\xf0\x04\x76\x10\x99\x06\x2e\xfc\x9b\x4b\xbd\x2d\x9c\x28\x02\xbf\x70\x21\xb3\x10\x35\x21\x05\x00\x80\x1d\xc5\x6c\x25\xd2\x84\xc9\x69\x70\x73\xc3\x17\x24\x7a\x95\xa6\x62\x93\x72\xa5\x77\xbb\x9f\x44\x29\x09\xc5\x7b\x58\xf2\xd7\xdf\xfc\xf8\xee\x2d\x01\x93\x41\x33\x8d\xa2\xe8\xe6\x86\xa5\x8a\xed\x76\xef\x45\x0c\xfb\x07\xc9\xad\xa4\xc8\x0f\xdf\xff\x9d\x38\x45\x83\x6d\x91\x2d\xa2\x38\x30\x35\x4f\x76\xbb\xc6\x12\x8c\xd5\xef\x2f\x32\x9c\xeb\xbc\x86\x02\xb3\x2b\xc1\x24\x3c\x20\x0c\x12\xf8\x0f\x13\xb6\xa0\x65\xaa\x49\x22\x45\x91\x88\x4d\x1e\x6a\xb1\x5c\xe2\x1e\x69\x97\x6f\x27\x05\x24\xa1\x9a\xba\xa1\x69\x50\xc1\x56\xda\xa7\xaa\x10\x45\x59\x38\xfd\xdb\x87\xec\x1a\xb8\x4a\x58\x82\xd6\x02\x4b\x0d\x66\xdf\x82\xcf\x92\x8c\x91\xaf\x7f\x7c\x7b\xd2\x35\xa5\x18\x82\x95\x0e\x9b\x28\xf7\x0c\x6a\x32\xb4\xac\xd8\x05\x11\xf7\x37\x29\xd3\x0a\x93\x5f\x00\xc4\xb4\x92\xb4\xee\x42\x89\xf1\x28\x80\x2d\x59\x42\x26\xc1\xc8\x13\x9e\x5c\x9f\x92\x27\x34\x13\x65\xae\xc9\x78\x0a\x7a\x33\x97\x6a\xb7\x6b\x61\x07\xfc\x29\x50\xa6\xb7\xb9\x05\x11\x79\x9c\xf2\xf8\x13\x8c\x72\xb0\x83\x9b\x1b\x44\xbe\xdb\x5d\x11\x63\x10\x4f\xa2\xef\x59\x4c\x0b\x0d\x69\xca\x6e\x07\x31\xca\x5d\x47\xec\x9a\xc5\x10\x92\xfa\x83\xca\x10\x54\x39\xcf\xb8\xee\x57\xd3\x07\x5e\xcb\xf0\xc4\xf2\x09\x6e\x34\x44\xa4\x20\xd4\x6b\xc0\xfb\x1d\x93\x5c\x24\x8a\x58\xf8\xc9\x90\x82\x84\x80\x59\x37\xaf\x2d\xa4\x61\x99\xd6\xd6\x32\x44\x73\xf1\xfe\x61\xdc\xcd\xda\xee\xbb\xc5\x02\x92\x01\xe6\x26\x1f\xf7\x9d\x4e\xa4\xd1\xa2\x18\x93\xcb\x46\x94\xb9\xc5\x22\xc1\x8a\xc1\x98\x66\xaf\x20\x8c\x82\x6d\x93\x55\x99\xd1\xfc\x25\xb1\xe0\xe8\x82\x4e\x3c\x21\x6c\xb9\x0a\x83\xb7\xd9\x93\x90\x5d\x32\x6d\xf3\xdd\xf4\x5b\x37\xa9\xf2\x5d\x7f\x7b\x3f\xff\x7d\x95\xab\x0d\x93\xe0\x5f\x41\x57\x36\x46\xa0\x56\x44\x0d\x65\x1e\x10\xd2\x32\xf4\x0a\x76\x0e\x03\x5b\x10\xfb\xc4\xb6\x18\x1d\x9a\x73\xdd\x28\x84\xfb\x74\x4e\xd1\x74\xac\xf6\xfd\xa4\x5f\x19\x8a\x6d\xcd\x95\xc9\x56\x67\x07\x18\x79\xaf\xe9\xa7\x8e\xa6\x8e\x68\x85\x34\x8d\xd6\xf8\x01\x86\x72\xda\x76\x68\x08\x9f\x29\x2d\xc0\x4b\x5d\x26\xf8\x87\x5c\x24\x40\xf8\x7b\xb6\x84\x50\x06\x1b\x3e\x05\x2c\x4a\x43\x94\x22\x38\x40\x3e\xcb\x7d\xe9\xac\x11\xdb\x1d\xcf\xa8\x3e\x43\xaa\x11\xd0\x2d\x23\x87\x14\x8d\x90\x21\x88\xf3\x0e\xaa\x3d\xbc\x19\x3e\x43\x2b\x6d\xab\xfd\x2d\xae\xa7\x28\xe7\xe0\xc2\x04\x50\x93\xfe\xe8\x7a\xf4\x0c\xac\x60\x70\x9c\x03\x34\xb3\x47\x67\xc1\x20\x3d\x4a\x92\x65\x94\xa7\x8f\x49\xf3\x5d\xc1\x24\xd5\x42\x12\x8b\xf9\x28\x61\xc8\x91\xa8\x71\xc4\xc7\x5e\xaf\x47\x7c\x94\xf4\xdd\xf6\xe0\xfb\xaf\x78\xc3\xe6\xe8\x96\x0d\xc2\xb7\x6f\x8c\x78\x6d\x72\xc5\xee\x96\xe8\x03\xbf\x74\x6e\xd2\x1f\x34\x5d\x26\x4f\x80\x39\xf0\x52\xe3\x32\xed\xdd\xab\x13\x5b\xee\x93\x04\x1d\x72\xf3\x8b\xf3\x66\xf4\x3d\x90\x1f\xbd\xe8\xe4\x47\x17\x07\x81\x21\xbc\xb2\x94\x98\xcf\x50\x65\x10\x9b\xdc\xb5\x13\x44\x43\x60\xdd\x49\x21\x66\xb4\x9e\x35\x9f\x19\x8f\xae\x88\x80\xbc\x76\x01\x29\x10\x64\xe1\xa5\x16\x57\x90\x22\x5f\xfb\xea\xe0\x62\x34\x6a\xed\x1a\x50\x38\x53\x88\x77\xc6\x02\x24\x33\x5b\x80\xf2\x5a\xb7\x43\xe6\x13\x95\x0f\xd1\x45\xb1\xa4\x23\x0d\xa4\x88\xd1\xc6\x40\x35\x36\xbc\x46\xd4\x39\xc0\xfb\x02\xea\x2c\x9f\x55\x37\xd8\x70\xa8\x1b\xb5\x01\xe0\xd6\xb2\x86\x03\xc0\xe4\x5e\x09\xb3\xc4\x82\xf8\x58\xbe\x5c\x6f\x82\x05\x63\x52\xd5\x3b\x9f\xb9\x85\x45\x25\x0f\xa0\x8c\xc1\x7e\x4e\x15\xbb\x0b\x79\x6b\xeb\x9e\xbc\xb9\x7d\x28\x7d\x28\x7d\xa4\x9e\x33\xaa\xef\xc2\xc0\xa2\xcc\x93\xc6\xfa\x21\x5f\x7c\x20\xf5\x32\x87\xd4\x53\x82\xd3\x6f\xef\x4a\x1e\x4c\xcb\xd3\xb7\xf7\x6d\x16\xe0\x4e\xde\x6e\x68\xcd\x9b\x47\xf2\xec\xdf\xaa\xde\x2e\x66\xff\x2d\x36\x24\x11\x4c\x11\xbd\xe2\x8a\x60\x45\xf1\x12\xaa\xac\x0b\x0f\x52\xcc\x3e\xe0\x00\x48\x14\xc4\x82\x35\x18\x81\x3b\x59\xe6\xa6\xd8\x80\x08\x08\x45\x5a\xbb\x6e\x73\x75\x49\x44\x3e\x08\xac\x7d\xd7\x20\x61\x70\x61\x88\x7a\x5c\x94\x8a\xd0\x18\xe2\xa9\x22\x0b\x29\x32\xc2\xae\x57\xb4\x84\x9c\x0d\x10\x61\xe4\xa0\x6b\xd8\x56\x8c\x1b\x19\x6d\x12\x88\xbb\x34\x8e\xcb\xac\xc4\xda\x1d\x60\x58\x2e\xca\xe5\xca\x70\xa2\x05\xb1\x79\x78\x2a\x60\xa0\xe2\x06\x64\x9f\x11\xaa\x35\xa4\x47\xea\x94\xec\x15\x57\x55\x7c\x00\x7d\x32\xe0\x3c\xdd\x22\x7a\x56\x60\x75\x09\xfb\x83\x59\x88\xab\x98\x40\x1a\x55\x11\x36\xdf\x9a\x01\xb7\x74\xe1\x36\x04\x5f\x8b\xb5\x70\x42\x32\x9f\x20\x6b\xb1\xc8\x32\x90\xcc\x85\x4c\x20\x1a\x4a\xbd\x25\xaa\x5d\xb1\x01\x59\x53\x39\x44\xe4\x55\xbe\x15\x39\x23\x2b\xba\x36\x42\x20\x1f\x6c\x6b\xe7\x94\x7c\x2b\x04\x24\x59\x4f\x51\x06\x7f\x85\x0d\x69\x2e\x84\x9f\x06\xc2\xdc\x12\x47\xd7\x49\x0a\x66\xad\xb8\x55\x05\x30\x98\x21\x8e\x84\xa4\x1c\x2e\x54\x55\xfa\x4d\x86\x45\x1d\xbf\xeb\xfa\x27\x0d\x57\x42\xf2\x5f\xb1\x7c\x4c\x83\xd9\x9e\xc8\xea\x08\xa8\x3b\xb1\xad\x0a\xcd\xc6\xee\x52\xb6\x80\xd8\xfc\xcc\x86\xe6\xae\x27\x21\xa6\x43\x3e\x54\x21\x34\x3d\x40\xcc\x5f\xc1\x74\x6d\xe3\xc1\xe6\x7e\x89\x6e\x04\xe0\xa4\x63\xe9\x96\xe2\xa5\x49\x56\xbb\xdd\x8b\x91\x47\x82\x26\xd8\x92\xd4\x29\xe8\xa6\xd8\x86\x05\x85\xed\xd6\x56\xca\x07\x8b\x6d\xc2\x73\x50\xa3\x31\x88\x39\xec\x46\xc4\x66\x19\x73\x71\x6d\x76\xe8\x05\x07\x5d\xd3\x0d\xdd\x7e\x09\x4c\x7a\x07\xaf\x2c\xe2\x31\x44\xe6\x3a\x7c\xbf\x17\xa9\x91\x35\x6f\x98\x66\x86\xf9\x09\x25\x50\x0e\xb4\x3b\xc0\x8e\x69\xd3\x3f\xe4\xa6\xff\x0d\x8f\x18\xd3\x2f\x31\xde\x4e\xbf\xb7\x08\xc1\xcc\xbf\x3a\x1f\x59\x6f\xc2\x0b\x44\x0f\xdf\x28\x6f\xf8\x82\x3c\xfa\x8e\x7f\x00\x2c\x72\xf8\x00\x1d\xc1\xe7\x57\xe7\x17\xcd\x10\x64\x9f\x18\x8d\x22\x08\x90\x85\xaf\x2a\x2c\x41\x46\x06\x2b\xc7\xee\xff\xff\x82\x72\x4b\x3d\x9e\xa7\x34\x87\xbd\xcb\xf0\x8a\x15\x87\xf1\xa6\x03\x6d\x14\x62\xac\x26\xa9\x6d\xc3\x75\xf9\x15\xe9\xab\x52\x42\xa9\x9a\x63\x06\x63\x4a\x27\x13\x50\xf3\x1e\xba\x2a\x8a\x64\x10\x4d\xe6\x72\x38\x7b\x5d\x9b\x1e\x4e\xdf\x13\xa0\x2a\x0b\x3c\x3e\x88\x9a\x82\xa4\xd8\xe1\x4b\x99\x1a\x5e\x8e\x9e\x5f\xbe\xb8\x95\x77\x85\x9d\x20\xb3\x80\x7b\x59\xef\x63\xd8\xeb\xd2\x44\xab\xb0\x48\x4b\x85\xe9\x22\xc7\x68\xf7\xbb\x32\x5e\x1b\x4e\xc9\x77\xc0\xe0\xa9\xad\xdc\xd4\x0a\x6c\x38\x67\x1b\xd8\xc4\xa1\x28\xc8\x97\x33\x5b\xcf\x61\xef\xd5\xdc\x92\x42\x00\x86\x63\xa6\xc0\xb2\x39\x83\x75\xed\x1b\xc3\x67\xda\x02\x12\x33\xfa\xfb\xff\xd7\xdd\xc2\x6d\x2f\xbf\x2b\x7d\x55\x7b\xde\xef\x52\x59\x7b\x8e\xbb\xd9\x6c\xa2\x4a\x8c\xc6\x6b\x57\x2c\x2d\x86\x98\x05\x40\x16\xa9\xb7\xc3\xaa\x33\x35\x7c\x09\xb9\xe2\xf9\xe5\xf9\x8b\x17\xe7\xcf\xfe\xeb\xf2\xf9\xf3\xf3\xcb\x67\xcf\x8f\xb9\xb4\xb7\x88\xcf\xf7\x68\xbb\x9b\xbf\x15\xd8\x48\xaf\xf7\x25\x63\x2c\x55\x81\x84\x99\x70\x82\xed\x4d\x19\x7c\xb6\x01\x95\x39\x66\xfb\x90\x50\x3f\x74\x97\x37\x36\x74\x0b\x67\x0f\xb4\xab\xca\x76\xd0\x4c\x40\xd0\xb8\xc2\xea\x7c\x01\x74\xe3\x6d\xe9\x94\x28\x9e\x15\x90\x1c\x76\x33\x85\x3b\x66\x08\xbf\x69\x53\x6d\x9d\x59\x0b\x33\x29\x76\x86\x3d\x0e\xf8\x56\xa5\xc2\xb4\x94\x9b\xe2\x55\x90\xbf\x6c\x7f\xa5\xc0\x26\xa4\x89\x2e\xb5\x8d\xc8\x3b\x4c\x5e\x4b\xc5\x4c\xde\x9a\xb0\x79\xb9\x5c\x9a\x6c\x5c\x42\xc2\xcd\xd7\x14\x38\x76\xbb\x9d\x6a\xa5\x28\x27\xad\xde\xc1\x89\xcf\x0c\x93\xb4\x91\xed\xff\x24\x4a\x12\x43\x59\xa3\x25\xd0\xb2\xde\x02\x0b\x42\x6f\x29\x98\x5d\x94\xcf\x79\xe7\x0c\x72\x27\x03\x62\x97\xbf\xe0\x2c\x35\x09\xb0\x62\x90\xd3\xc2\x50\x56\xc6\x2b\xdb\xb4\xa5\x6b\xb3\x96\x0d\xe5\x9a\x40\x0e\xcb\x53\x2b\x53\x5d\xca\x1c\xd3\x65\xa6\xa2\x46\x82\xda\x6a\x57\x62\x01\xd2\xec\x23\x56\x29\xb8\x32\x79\x30\x4d\x95\x70\xd8\xeb\x2c\x5d\xee\xf7\x53\x60\x94\x4b\x83\x00\xea\x92\x95\xed\xaf\x18\x59\xe3\x68\x62\x43\x07\x62\xc8\x78\xce\xb3\x32\x73\xb3\xc4\xc2\x3c\xf4\x79\x3b\xa0\x83\x94\x9a\x88\x4d\x0e\xa5\xe1\x8a\x17\x88\x01\xe4\x94\xab\x05\x03\x11\x99\xa5\x23\xbc\xf4\xb9\x8e\x3f\x82\xc1\xd5\x75\xa5\xdf\x69\x0e\x4f\x58\x36\xfb\x50\x2f\xa2\x51\x65\xf9\xb6\x2e\x60\x7e\x6d\xc1\x41\xd1\x42\xb3\x18\x2d\x97\xd0\x25\xe5\xb9\x42\xeb\xd3\x48\x08\xd0\xb4\xda\xbe\xbe\x45\x7f\x9c\x00\xb5\x36\x0f\xaa\xbe\x07\xf6\x5b\xea\x54\x7f\xe5\x2e\xea\xb3\x67\x33\x3c\x1c\x92\x6f\x53\x31\x87\x8a\x68\x8d\x01\x03\x16\xa6\x50\x74\xd8\x75\x6b\x19\x1c\x28\x41\x43\xc9\xe8\xb4\x60\xd9\xc6\xf9\x30\x0b\x7d\x81\x65\x85\x26\x53\x77\x72\x8a\xcf\x14\x93\x6b\x77\x1e\x8c\xb7\x78\xb6\xd2\x1a\xf7\x86\x3b\x25\x3f\xff\x72\xf5\x85\x63\xe5\x6b\xb6\x30\xce\x85\x61\xc2\x2e\x59\xaf\x28\x84\x48\xc9\xc0\x91\x14\x04\x24\x01\x1e\x6d\x39\xc4\x03\x22\x82\x5c\x56\x98\x2a\xcc\x38\x50\x18\x6a\x15\x92\xfe\x8a\xaa\xd5\xc0\x1d\xfc\x4a\x66\x0c\xdd\x8f\x55\xcf\x4f\xd0\x7f\xfb\x88\x80\x4f\x47\x57\x84\x4f\x2a\xbc\x51\xca\xf2\xa5\x5e\xc1\xa3\xa7\x4f\x3d\xf0\x09\x68\xb3\x5f\x41\xfc\xcc\x7f\x89\xf4\x75\x84\x54\xc8\x74\x4a\x9a\xd4\x0c\x41\x87\x47\x15\xb0\x4f\xb2\x3e\x3f\x25\x67\x83\xab\x6a\x74\x0e\x4b\xfb\x54\xdd\x39\x3d\xda\x2f\xf3\xb9\xbb\x6a\x4b\xc6\x08\xbf\x25\x1b\x7b\xf4\x00\x45\x2f\x41\x47\x23\xa5\x4c\x2b\xd3\xb7\x2a\xf0\x0a\x31\x70\x4d\xa9\xec\x59\xbd\xbb\x70\x36\x55\x2d\xc1\xa2\x89\x14\x3c\xec\xff\xcf\xfb\x77\x6f\x23\x08\xce\x60\xa8\x7c\xb1\xed\xdf\x00\xb5\x31\x79\xd2\x0f\xfe\x80\xcd\xde\xc1\xcf\xa3\x5f\xa2\x35\x4d\x4b\x76\x6a\xf4\x3d\x36\x9f\x7b\x54\x4e\x2b\xcb\x1e\x93\x36\xc1\x8e\x8b\x34\xe0\x90\x42\x75\x30\x53\x53\x71\xd3\x76\x83\xc1\xd5\xe1\xd3\x9d\xc6\x79\x1d\x18\x0d\xd3\x7d\x04\x3c\x44\xca\x40\x1f\x26\x02\x02\x0b\x82\xab\x86\x9b\x81\x46\x5a\x31\xf1\xce\xea\x31\x61\xce\x86\x43\x49\x2d\xc0\x21\x3d\xf9\x80\x39\xdd\xb7\xd1\x07\xa8\x62\xf4\x99\xd2\x3d\x35\x7c\x8f\x2b\x73\xb6\xc9\xde\xdf\xd8\xd6\x4e\xf3\x27\x3a\x0d\x82\x16\x10\xcf\x43\xc6\xf6\xf8\xd2\x03\x9a\x33\x92\x3d\x48\x73\x82\x31\x6e\x43\xda\x53\x8d\x3d\xd0\xea\xd0\x61\xdc\x00\xf5\x07\x11\x7b\xd0\x46\x22\x1d\x16\xda\xc2\xb1\x7e\x56\x1b\xd0\xe7\x1b\x84\xb9\xeb\xc4\xb0\x8c\x41\x72\x63\x36\x22\xb0\x43\x91\xe7\x10\xc4\x49\x59\x80\xde\xad\x1a\x09\x04\x33\x55\x6b\xbd\x82\x38\xaa\x76\x18\xc1\xb4\xfb\x9f\x6c\xfe\x1e\x72\x3c\xb0\xe6\x7e\x7f\xc3\xf3\x44\x6c\xa2\x4a\x04\xf8\x1e\x8d\x16\xb1\x48\x21\x0a\x01\x97\x36\x25\x0e\x06\xe4\x25\x09\x36\x0a\x93\xe3\x80\x8c\xf1\x12\xaf\x06\xe4\x29\xe9\x4e\x5f\x61\xe6\xfe\x94\x04\x43\x5a\xf0\x60\x60\x83\x72\x65\x73\x22\x87\xc4\x40\xd1\x25\x6b\x32\x68\x1a\x8a\x3e\xd4\xe1\x3a\x32\xb5\x04\x00\x63\x9b\x05\xbe\xd8\x67\x41\x22\xec\x5f\x57\x31\x0f\x23\xa7\x01\x03\x1e\xf3\x32\x4d\xeb\x50\x69\x43\xf3\x55\x15\x04\x5b\xe0\x91\x4d\x1c\xbf\x84\x49\xd8\xcf\x45\x11\x27\xf5\x4c\x54\x95\x6d\x3b\x0f\x22\xcc\xf3\xea\x19\x83\xab\x66\x4c\x6d\x61\x83\x24\xe1\x37\xd0\xb1\xa4\x8b\x0f\xa0\x0e\x23\x34\x5d\xfe\xdb\xf0\xd9\x53\x81\x06\x3a\xf3\xe0\x08\xb6\xbc\x84\x3a\x49\xde\x86\xce\x76\xf9\x1d\x3a\x23\xea\x37\xb9\x6e\xcc\x85\x4d\xe6\xc5\xe0\x08\xf6\x2a\xb3\xb8\x05\xfd\xde\x41\x7e\x83\x71\x37\x76\x04\x39\x64\x5f\xe2\x28\xe7\xf8\x12\x5e\xff\x26\xa5\x5b\xac\xae\x48\x4f\x8b\xe2\xb5\x69\xfa\xf7\x4e\x4d\x7a\x3e\x26\x1e\xc3\xa9\x39\xac\x03\x18\x73\x87\xe3\x3c\x63\x66\xd6\xf3\xd1\x68\x74\x4a\xaa\x57\xc6\xfe\x42\x71\x9f\x81\xea\x66\x77\x84\x1f\x55\xc6\x31\x16\x09\x0f\xe1\xc8\xe1\xf0\x3c\xb9\xfb\x07\x70\xe5\xd3\x9f\x16\x5b\xe4\x8f\x7f\x24\x7b\xa3\x6d\x1f\x81\x00\xf3\x0f\x8a\xf9\x2f\x1e\xef\x49\xb6\x36\x8d\x7c\x0f\x9f\x71\xa5\x4c\x12\xa9\xa0\xd2\xc9\x99\x9b\x73\xbf\xcc\x66\x8f\x47\x07\x46\x66\x64\xd4\x65\x10\xa3\x62\x23\xf3\x39\x90\x10\x35\xf0\xb6\x73\x9d\x93\x5d\x93\x5e\x6b\x26\xc8\x14\x56\x0e\x61\xb6\x39\x79\x0f\xc2\xc4\x61\x3f\x0c\xdb\xfb\x07\xab\x8b\xbe\x4b\x00\x0f\xa5\x67\x83\x53\x3c\xb5\x1c\x0d\xf6\x98\xd8\xd5\xe2\x7d\x55\x60\x71\x05\x05\xc6\xd6\xc4\x5b\x2f\x5b\x53\x65\x62\xa1\x84\xf1\x32\xc5\x13\xd9\xd4\xa6\xe5\x6e\x2a\x0a\xd8\x9d\x36\x4c\x49\x78\x76\x75\x20\x51\x6c\x48\xb2\xb1\xb4\xae\x7a\x0e\xc8\xbe\xab\xa2\xb6\xcc\x3a\xc0\xe1\x59\x4b\x29\x2d\x7d\x1d\x56\xcc\x89\xe7\x9b\xd7\x12\xed\xa8\xab\xd6\x57\x57\x66\x0d\xfe\x2d\x9e\xa7\x67\x77\x5c\x86\x1f\x2e\x4a\xb5\xea\x77\x18\x1d\x5c\xed\xeb\xe6\x8d\xc6\x82\x93\x99\x63\x69\xa3\x0b\x6c\x1a\x48\xb6\xa7\x12\x53\x1e\x4a\x16\x42\xe9\x92\x30\x59\xa5\x65\xb6\x0d\x80\x35\x4e\x4b\x65\xb6\xff\xd4\x34\xa7\x7b\x3a\x8c\xa9\x3a\xf0\xec\x08\xfe\x3a\x4e\x60\x0c\xb5\x65\xa9\x08\xcc\xcc\x3b\x32\x09\x00\xdb\x37\x78\xfb\x83\xa8\xcc\xf9\x75\x7f\x10\xba\xfb\x2e\x8e\x6a\xfc\xca\x37\x94\x2a\xb6\x9f\x02\xf2\x89\x96\x78\xf2\xd9\x0b\x60\xf3\x3e\x54\x93\xc0\x96\xde\x9b\xd5\x1c\x34\xa7\x12\x32\xd1\xc9\xac\xf1\xfe\xd1\xbf\x02\x7c\xa3\x69\x69\xba\x26\x63\xac\x26\xfa\x7b\x68\xe9\x1a\x76\x73\x69\xb0\x0e\xae\x48\x0d\xee\x5a\x4a\x31\x2a\xe7\x8a\xd8\xde\x95\x39\x00\x25\xfe\x8d\x01\x73\x37\x17\x12\x94\x12\x4a\x9a\xf0\x52\xd9\x77\x3d\xfe\x55\xbd\x24\x65\x8e\x69\x6f\x65\x15\x82\xde\x6c\x8f\x23\x77\x1a\x07\x2c\x41\x71\x0f\x00\xbf\x85\xc6\x2f\xb6\xf9\xe6\x30\x39\x70\x18\x4d\xfc\x7b\xbd\xee\x79\xc6\x93\x24\x65\xc8\x70\x8d\x1e\x9d\x11\xf5\xdf\x74\xa9\x36\x49\xe2\x4e\xa1\xeb\x39\x3b\x82\x47\x54\xb7\x4c\xf0\x07\xda\x3d\x34\x80\x10\x97\xcc\x8d\xcc\x5d\x5b\xce\x3c\x96\x3d\x23\x0b\xf7\x1e\x78\x52\xda\xc2\xa2\x1f\x3a\x03\x3b\x85\xad\x0a\x13\xcb\x44\xf5\x06\x91\x79\x59\x0f\x34\xd4\xc7\x7d\x69\x60\x65\x65\x4e\xc8\x83\xfd\x90\xbc\xc7\x4c\x7d\x74\xdd\xab\xf6\xb8\x9e\x13\x62\xaf\xd2\xee\xb3\xba\x0b\x88\x6f\x72\xf4\xee\x29\xa1\xc3\x54\xc2\x39\x95\xa4\x79\x13\x56\x9b\x2f\x91\x02\xa9\x57\x63\x30\xd4\xb3\x3d\x4f\x93\xa6\xe7\x62\x33\xed\x5d\x8c\x3c\x93\x56\xd1\x46\xcf\x3d\x67\x6b\x7b\xca\x40\x2e\x2b\xd7\x9c\xc1\x46\xf1\x18\xdc\xda\xbe\x69\x67\x05\x50\xb5\x15\x40\x83\xc6\xf8\x1e\xfc\xff\xc1\x42\x1e\x41\xc8\xf7\x66\x11\xed\xb0\x12\x9e\x31\xd3\x16\xbf\x38\xea\x65\xfb\x27\xf4\x37\x32\x34\x12\x06\xd0\x83\x0b\x39\x6a\x89\x1d\xc0\x8e\x6b\x1f\xf7\x7b\xf3\xca\x47\xd0\xdd\x53\x30\xd7\xf5\xef\x2a\x81\x8f\xe8\x2c\xed\x43\x3c\x35\x6f\xf8\x23\xcf\x1e\x83\x41\x60\x1f\xb7\x53\xba\x5d\xbb\x4a\xc2\x16\x15\xeb\x14\x71\xa4\x91\x9c\xf8\x42\xaf\xca\x44\xc8\xae\xfe\x21\x04\xec\x70\xef\x21\xb4\x6a\x28\x1e\x7f\x78\x03\xb5\x22\x94\x4d\xb8\x7b\x09\x82\xfb\xa3\x3d\x9c\xaa\x7e\x29\x01\x2a\x50\xd8\x72\xde\x50\x99\xb8\x2e\x2e\x8c\x6f\xcd\xbb\x0f\x55\xea\x07\x64\xdf\x60\x14\x03\x25\xf5\xf7\x8a\xca\x27\xfd\x5e\xd4\x54\x39\x44\x08\x46\xe3\xd5\x3e\xa0\xd9\xb1\x3c\xdd\x29\x79\x6b\xea\x8b\xfe\x93\x3e\xbe\xa4\x32\x88\xa8\xd6\xb2\xdf\x6b\x19\x43\x6f\x80\x7a\x3d\x6b\xd4\x7b\x7e\xfa\xa4\xe5\x56\xb7\xe1\xa8\x93\x69\x9f\x08\x54\xe0\xb1\x52\x7d\x6b\x57\x00\x55\xe3\x6e\x9b\x55\xef\xab\x9e\x57\x54\xed\xde\xf5\x3a\xa6\x07\x39\x69\xa1\xee\xa1\x97\xf5\xf6\xc8\xd3\x24\x79\x8d\xfe\xd3\x0f\x0e\x78\x7a\xd7\x3a\x06\x5e\xd8\x36\x5e\xdf\x2a\x65\xfb\x6e\xf8\x11\x11\xf3\x04\x26\xab\x72\x6e\x7b\x3e\xfd\xe7\xbe\xba\xab\xc0\x8c\xf1\x76\xb7\x82\xbd\x84\x02\x49\xb4\x93\x8a\xb0\x93\x84\xdc\xb2\x6b\x38\x92\x76\x55\xbb\x53\x14\xf8\x68\xe0\xbb\xb7\xdf\x28\x4c\xae\xec\x09\x21\xbe\xa5\x69\xda\x14\xc4\xd9\x7b\xa3\xe1\xf5\xea\xbb\x37\x8d\xa6\x97\xf7\x88\xbe\xc1\xee\x7f\xc4\x74\xa8\xa7\x77\xf0\x57\x53\x78\xe4\x67\x4f\xbd\xcd\x81\x9f\x6f\xfa\x61\x1b\x03\x7f\x17\x05\xd5\xd0\x36\x8f\x09\xd4\x58\x4c\xce\x1a\xe8\x5d\x07\x67\x32\xb4\xbf\xe7\x99\x0c\xed\x4f\x16\xff\x03\x2e\xd3\x78\x52\xc3\x38\x00\x00")
 
 func faucetHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -153,6 +153,9 @@ func makeFullNode(ctx *cli.Context) *node.Node {
 	stack, cfg := makeConfigNode(ctx)
 
 	utils.RegisterDexService(stack, &cfg.Dex)
+	if ctx.GlobalBool(utils.DeveloperFlag.Name) {
+		utils.RegisterDeveloperNodes(ctx, stack, &cfg.Dex)
+	}
 
 	if ctx.GlobalBool(utils.DashboardEnabledFlag.Name) {
 		utils.RegisterDashboardService(stack, &cfg.Dashboard, gitCommit)
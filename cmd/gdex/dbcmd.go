@@ -0,0 +1,304 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dexon-foundation/dexon/cmd/utils"
+	"github.com/dexon-foundation/dexon/core/rawdb"
+	"github.com/dexon-foundation/dexon/core/state/pruner"
+	"github.com/dexon-foundation/dexon/dex"
+	"github.com/dexon-foundation/dexon/dex/db"
+	"github.com/dexon-foundation/dexon/ethdb"
+	"github.com/dexon-foundation/dexon/trie"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	dbCommand = cli.Command{
+		Name:     "db",
+		Usage:    "Low level database operations",
+		Category: "BLOCKCHAIN COMMANDS",
+		Subcommands: []cli.Command{
+			{
+				Name:      "prune-core",
+				Usage:     "Prune finalized consensus core blocks of old rounds",
+				ArgsUsage: " ",
+				Action:    utils.MigrateFlags(pruneCore),
+				Category:  "BLOCKCHAIN COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.CacheFlag,
+					utils.GCCoreRoundsFlag,
+				},
+				Description: `
+    gdex db prune-core --gcmode.corerounds <rounds>
+
+deletes the consensus core blocks which are already finalized into the
+compaction chain and belong to rounds older than the most recent <rounds>
+rounds of the local chain. The node must not be running.`,
+			},
+			{
+				Name:      "prune-state",
+				Usage:     "Prune historical state not needed by the chain",
+				ArgsUsage: " ",
+				Action:    utils.MigrateFlags(pruneState),
+				Category:  "BLOCKCHAIN COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.CacheFlag,
+					utils.GCStateBlocksFlag,
+					utils.GCStateBloomFlag,
+				},
+				Description: `
+    gdex db prune-state --gcmode.stateblocks <blocks>
+
+deletes all the state trie nodes and contract codes which are not reachable
+from the state at the start height of every round or from the state of the
+most recent <blocks> blocks. Live state is marked in a bloom filter, whose
+size is set by --gcmode.statebloom. The node must not be running.`,
+			},
+			{
+				Name:      "migrate",
+				Usage:     "Copy the chain database into a database of another engine",
+				ArgsUsage: "<engine> <dest>",
+				Action:    utils.MigrateFlags(migrateDatabase),
+				Category:  "BLOCKCHAIN COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.DBEngineFlag,
+					utils.CacheFlag,
+				},
+				Description: `
+    gdex db migrate <engine> <dest>
+
+copies every entry of the chain database, opened with the engine set by
+--db.engine, into a new database created with <engine> in the directory
+<dest>. To switch the node to the new database, replace the chaindata
+directory with <dest> and start the node with --db.engine <engine>. The node
+must not be running.`,
+			},
+			{
+				Name:      "export-signprotection",
+				Usage:     "Export the slashing protection records into a file",
+				ArgsUsage: "<filename>",
+				Action:    utils.MigrateFlags(exportSignProtection),
+				Category:  "BLOCKCHAIN COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.DBEngineFlag,
+				},
+				Description: `
+    gdex db export-signprotection <filename>
+
+writes the highest position and period the node signed in each round, as
+recorded in the slashing protection database, into <filename> as JSON. Use it
+with import-signprotection when moving a node to another machine, so the node
+does not sign again what it signed on the old one. The node must not be
+running.`,
+			},
+			{
+				Name:      "import-signprotection",
+				Usage:     "Import the slashing protection records from a file",
+				ArgsUsage: "<filename>",
+				Action:    utils.MigrateFlags(importSignProtection),
+				Category:  "BLOCKCHAIN COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.DBEngineFlag,
+				},
+				Description: `
+    gdex db import-signprotection <filename>
+
+merges the records exported by export-signprotection into the slashing
+protection database, keeping the higher record of each round. The node must
+not be running.`,
+			},
+		},
+	}
+)
+
+// pruneCore deletes old finalized core blocks from the chain database.
+func pruneCore(ctx *cli.Context) error {
+	keep := ctx.GlobalUint64(utils.GCCoreRoundsFlag.Name)
+	if keep == 0 {
+		utils.Fatalf("Number of rounds to keep must be specified with --%s",
+			utils.GCCoreRoundsFlag.Name)
+	}
+	stack := makeFullNode(ctx)
+	chainDb := utils.MakeChainDatabase(ctx, stack)
+	defer chainDb.Close()
+
+	hash := rawdb.ReadHeadBlockHash(chainDb)
+	number := rawdb.ReadHeaderNumber(chainDb, hash)
+	if number == nil {
+		utils.Fatalf("Failed to find the head block")
+	}
+	header := rawdb.ReadHeader(chainDb, hash, *number)
+	if header == nil {
+		utils.Fatalf("Failed to read the head block header")
+	}
+	if header.Round <= keep {
+		fmt.Printf("Chain is at round %d, nothing to prune\n", header.Round)
+		return nil
+	}
+
+	start := time.Now()
+	pruned, err := db.NewDatabase(chainDb).PruneBlocks(header.Round - keep)
+	if err != nil {
+		utils.Fatalf("Failed to prune core blocks: %v", err)
+	}
+	fmt.Printf("Pruned %d core blocks before round %d in %v\n",
+		pruned, header.Round-keep, time.Since(start))
+	return nil
+}
+
+// migrateDatabase copies the chain database into a new database created with
+// another engine.
+func migrateDatabase(ctx *cli.Context) error {
+	if len(ctx.Args()) != 2 {
+		utils.Fatalf("This command requires two arguments.")
+	}
+	engine, dest := ctx.Args().Get(0), ctx.Args().Get(1)
+	if _, err := os.Stat(dest); err == nil {
+		utils.Fatalf("Destination %s already exists", dest)
+	}
+
+	stack := makeFullNode(ctx)
+	chainDb := utils.MakeChainDatabase(ctx, stack)
+	defer chainDb.Close()
+
+	destDb, err := ethdb.Open(engine, dest, ctx.GlobalInt(utils.CacheFlag.Name), 256)
+	if err != nil {
+		utils.Fatalf("Failed to create destination database: %v", err)
+	}
+	defer destDb.Close()
+
+	start := time.Now()
+	count, size, err := ethdb.Copy(destDb, chainDb)
+	if err != nil {
+		utils.Fatalf("Failed to migrate database: %v", err)
+	}
+	fmt.Printf("Migrated %d entries (%v) to %s database %s in %v\n",
+		count, size, engine, dest, time.Since(start))
+	return nil
+}
+
+// pruneState deletes the state entries unreachable from the retained state
+// roots from the chain database.
+func pruneState(ctx *cli.Context) error {
+	keep := ctx.GlobalUint64(utils.GCStateBlocksFlag.Name)
+	if keep == 0 {
+		utils.Fatalf("Number of recent block states to keep must be specified with --%s",
+			utils.GCStateBlocksFlag.Name)
+	}
+	stack := makeFullNode(ctx)
+	chain, chainDb := utils.MakeChain(ctx, stack)
+	defer chainDb.Close()
+
+	roots, err := chain.RetainedStateRoots(keep)
+	if err != nil {
+		utils.Fatalf("Failed to collect retained state roots: %v", err)
+	}
+	chain.Stop()
+
+	start := time.Now()
+	p := pruner.NewPruner(chainDb, ctx.GlobalUint64(utils.GCStateBloomFlag.Name)*1024*1024)
+	if err := p.Start(); err != nil {
+		utils.Fatalf("Failed to start state pruning: %v", err)
+	}
+	if err := p.Prune(trie.NewDatabase(chainDb), roots, nil, nil); err != nil {
+		utils.Fatalf("Failed to prune state: %v", err)
+	}
+	fmt.Printf("State pruning done in %v\n", time.Since(start))
+
+	if db, ok := chainDb.(*ethdb.LDBDatabase); ok {
+		start = time.Now()
+		fmt.Println("Compacting entire database...")
+		if err := db.LDB().CompactRange(util.Range{}); err != nil {
+			utils.Fatalf("Compaction failed: %v", err)
+		}
+		fmt.Printf("Compaction done in %v.\n\n", time.Since(start))
+	}
+	return nil
+}
+
+// openSignProtection opens the slashing protection database of the node.
+func openSignProtection(ctx *cli.Context) (*dex.SignProtection, ethdb.Database) {
+	stack := makeFullNode(ctx)
+	db, err := stack.OpenDatabase(dex.SignProtectionDatabase, 16, 16)
+	if err != nil {
+		utils.Fatalf("Failed to open slashing protection database: %v", err)
+	}
+	return dex.NewSignProtection(db), db
+}
+
+// exportSignProtection writes the slashing protection records into a file.
+func exportSignProtection(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		utils.Fatalf("This command requires an argument.")
+	}
+	store, db := openSignProtection(ctx)
+	defer db.Close()
+
+	out, err := os.OpenFile(ctx.Args().First(), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		utils.Fatalf("Failed to create export file: %v", err)
+	}
+	defer out.Close()
+
+	if err := store.Export(out); err != nil {
+		utils.Fatalf("Export error: %v", err)
+	}
+	fmt.Println("Exported slashing protection records")
+	return nil
+}
+
+// importSignProtection merges the slashing protection records of a file into
+// the database.
+func importSignProtection(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		utils.Fatalf("This command requires an argument.")
+	}
+	store, db := openSignProtection(ctx)
+	defer db.Close()
+
+	in, err := os.Open(ctx.Args().First())
+	if err != nil {
+		utils.Fatalf("Failed to open import file: %v", err)
+	}
+	defer in.Close()
+
+	if err := store.Import(in); err != nil {
+		utils.Fatalf("Import error: %v", err)
+	}
+	latest, err := store.Latest()
+	if err != nil {
+		utils.Fatalf("Failed to read records: %v", err)
+	}
+	if latest != nil {
+		fmt.Printf("Imported slashing protection records, latest at round %d height %d period %d\n",
+			latest.Round, latest.Height, latest.Period)
+	} else {
+		fmt.Println("Imported slashing protection records, nothing signed")
+	}
+	return nil
+}
@@ -69,7 +69,9 @@ var AppHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			configFileFlag,
 			utils.DataDirFlag,
+			utils.AncientFlag,
 			utils.KeyStoreDirFlag,
+			utils.DBEngineFlag,
 			utils.NoUSBFlag,
 			utils.NetworkIdFlag,
 			utils.TestnetFlag,
@@ -77,6 +79,9 @@ var AppHelpFlagGroups = []flagGroup{
 			utils.YilanFlag,
 			utils.SyncModeFlag,
 			utils.GCModeFlag,
+			utils.GCCoreRoundsFlag,
+			utils.GCStateBlocksFlag,
+			utils.GCStateBloomFlag,
 			utils.EthStatsURLFlag,
 			utils.IdentityFlag,
 			utils.LightServFlag,
@@ -90,6 +95,7 @@ var AppHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			utils.DeveloperFlag,
 			utils.DeveloperPeriodFlag,
+			utils.DeveloperNodesFlag,
 		},
 	},
 	{
@@ -183,6 +189,9 @@ var AppHelpFlagGroups = []flagGroup{
 			utils.NetrestrictFlag,
 			utils.NodeKeyFileFlag,
 			utils.NodeKeyHexFlag,
+			utils.NodeKeyKeystoreFlag,
+			utils.NodeKeyPasswordFlag,
+			utils.NodeKeySignerFlag,
 		},
 	},
 	{
@@ -8,7 +8,9 @@ import (
 
 	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
 	dkgTypes "github.com/dexon-foundation/dexon-consensus/core/types/dkg"
+	"github.com/dexon-foundation/dexon/accounts/abi"
 	"github.com/dexon-foundation/dexon/cmd/utils"
+	"github.com/dexon-foundation/dexon/common/hexutil"
 	"github.com/dexon-foundation/dexon/core/vm"
 	"github.com/dexon-foundation/dexon/rlp"
 	"gopkg.in/urfave/cli.v1"
@@ -23,6 +25,7 @@ func init() {
 	app = utils.NewApp(gitCommit, "DEXON governance tool")
 	app.Commands = []cli.Command{
 		commandDecodeInput,
+		commandDecodeRevert,
 	}
 }
 
@@ -147,6 +150,33 @@ var commandDecodeInput = cli.Command{
 	Action:      decodeInput,
 }
 
+func decodeRevert(ctx *cli.Context) error {
+	revertHex := ctx.Args().First()
+	if revertHex == "" {
+		utils.Fatalf("no revert data specified")
+	}
+
+	data, err := hexutil.Decode(revertHex)
+	if err != nil {
+		utils.Fatalf("failed to decode revert data")
+	}
+
+	reason, err := abi.UnpackRevert(data)
+	if err != nil {
+		utils.Fatalf("%s", err)
+	}
+	fmt.Printf("Reason: %s\n", reason)
+	return nil
+}
+
+var commandDecodeRevert = cli.Command{
+	Name:        "decode-revert",
+	Usage:       "decode governance revert data",
+	ArgsUsage:   "[ <hex-data> ]",
+	Description: `decode the revert reason returned by the governance contract`,
+	Action:      decodeRevert,
+}
+
 func main() {
 	if err := app.Run(os.Args); err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dexon-foundation/dexon/cmd/utils"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+var commandAddress = cli.Command{
+	Name:      "address",
+	Usage:     "print the node key address of a node key",
+	ArgsUsage: "[ <keyfile> | <public key> ]",
+	Description: `
+Print the node key address the governance contract indexes the node by. The
+node key is given either as a keyfile or as a hex encoded public key.`,
+	Action: func(ctx *cli.Context) error {
+		pubKey := loadPublicKey(ctx.Args().First())
+
+		address, err := vm.PublicKeyToNodeKeyAddress(crypto.FromECDSAPub(pubKey))
+		if err != nil {
+			utils.Fatalf("Failed to derive node key address: %v", err)
+		}
+		fmt.Printf("Node Key Address: %s\n", address.String())
+		return nil
+	},
+}
@@ -25,9 +25,30 @@ func init() {
 	app.Commands = []cli.Command{
 		commandGenerate,
 		commandInspect,
+		commandAddress,
+		commandRegister,
+		commandReplace,
+		commandNotary,
+		commandSigner,
 	}
 }
 
+// Commonly used command line flags.
+var (
+	rpcFlag = cli.StringFlag{
+		Name:  "rpc",
+		Usage: "RPC endpoint of a DEXON node (admin API required for notary queries)",
+	}
+	ownerKeyFlag = cli.StringFlag{
+		Name:  "ownerkey",
+		Usage: "encrypted keystore file of the node owner account",
+	}
+	passphraseFlag = cli.StringFlag{
+		Name:  "passwordfile",
+		Usage: "the file that contains the passphrase for the owner keystore",
+	}
+)
+
 var commandGenerate = cli.Command{
 	Name:        "generate",
 	Usage:       "generate new keyfile",
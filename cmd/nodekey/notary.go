@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/dexon-foundation/dexon/cmd/utils"
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+var roundFlag = cli.Int64Flag{
+	Name:  "round",
+	Usage: "round to check, the round of the latest block if negative",
+	Value: -1,
+}
+
+var commandNotary = cli.Command{
+	Name:      "notary",
+	Usage:     "check whether a node key is in the notary set of a round",
+	ArgsUsage: "[ <keyfile> | <public key> ]",
+	Description: `
+Check whether a node key is in the notary set of a round. The notary set is
+queried from the node given by --rpc, which has to expose the admin API, e.g.
+over IPC.`,
+	Flags: []cli.Flag{
+		rpcFlag,
+		roundFlag,
+	},
+	Action: func(ctx *cli.Context) error {
+		pubKeyBytes := crypto.FromECDSAPub(loadPublicKey(ctx.Args().First()))
+
+		rpcClient, client := dialNode(ctx)
+		defer rpcClient.Close()
+
+		round := ctx.Int64(roundFlag.Name)
+		if round < 0 {
+			header, err := client.HeaderByNumber(context.Background(), nil)
+			if err != nil {
+				utils.Fatalf("Failed to retrieve latest block: %v", err)
+			}
+			round = int64(header.Round)
+		}
+		var notarySet []hexutil.Bytes
+		if err := rpcClient.Call(&notarySet, "admin_notarySet", round); err != nil {
+			utils.Fatalf("Failed to retrieve notary set of round %d: %v", round, err)
+		}
+		notary := false
+		for _, key := range notarySet {
+			if bytes.Equal(key, pubKeyBytes) {
+				notary = true
+				break
+			}
+		}
+		address, err := vm.PublicKeyToNodeKeyAddress(pubKeyBytes)
+		if err != nil {
+			utils.Fatalf("Failed to derive node key address: %v", err)
+		}
+
+		fmt.Printf("Node Key Address: %s\n", address.String())
+		fmt.Printf("Round: %d\n", round)
+		fmt.Printf("Notary Set Size: %d\n", len(notarySet))
+		fmt.Printf("Notary: %v\n", notary)
+		return nil
+	},
+}
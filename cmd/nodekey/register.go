@@ -0,0 +1,75 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/dexon-foundation/dexon/cmd/utils"
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	nameFlag = cli.StringFlag{
+		Name:  "name",
+		Usage: "name of the node",
+	}
+	emailFlag = cli.StringFlag{
+		Name:  "email",
+		Usage: "contact email of the node operator",
+	}
+	locationFlag = cli.StringFlag{
+		Name:  "location",
+		Usage: "location of the node",
+	}
+	urlFlag = cli.StringFlag{
+		Name:  "url",
+		Usage: "website of the node operator",
+	}
+)
+
+var commandRegister = cli.Command{
+	Name:      "register",
+	Usage:     "produce the call data registering a node",
+	ArgsUsage: "[ <keyfile> | <public key> ]",
+	Description: `
+Produce the call data of the governance contract register call for a node key.
+The transaction has to be sent by the node owner account with a value of at
+least the minimum stake of the network.`,
+	Flags: []cli.Flag{
+		nameFlag,
+		emailFlag,
+		locationFlag,
+		urlFlag,
+	},
+	Action: func(ctx *cli.Context) error {
+		pubKey := loadPublicKey(ctx.Args().First())
+
+		data, err := registerData(pubKey, ctx.String(nameFlag.Name), ctx.String(emailFlag.Name),
+			ctx.String(locationFlag.Name), ctx.String(urlFlag.Name))
+		if err != nil {
+			utils.Fatalf("Failed to assemble register call: %v", err)
+		}
+
+		fmt.Printf("To: %s\n", vm.GovernanceContractAddress.String())
+		fmt.Printf("Data: %s\n", hexutil.Encode(data))
+		return nil
+	},
+}
+
+// registerData packs the register call of a node, checking the node
+// information against the limits of the governance contract.
+func registerData(pubKey *ecdsa.PublicKey, name, email, location, url string) ([]byte, error) {
+	if len(name) >= 32 || len(email) >= 32 || len(location) >= 32 {
+		return nil, errors.New("name, email and location must be shorter than 32 bytes")
+	}
+	if len(url) >= 128 {
+		return nil, errors.New("URL must be shorter than 128 bytes")
+	}
+	return vm.GovernanceABI.ABI.Pack("register",
+		crypto.FromECDSAPub(pubKey), name, email, location, url)
+}
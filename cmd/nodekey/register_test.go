@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
+)
+
+func TestRegisterData(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	data, err := registerData(&key.PublicKey, "node", "node@dexon.org", "Taipei", "https://dexon.org")
+	if err != nil {
+		t.Fatalf("failed to pack register call: %v", err)
+	}
+	method := vm.GovernanceABI.ABI.Methods["register"]
+	if !bytes.Equal(data[:4], method.Id()) {
+		t.Fatalf("method id mismatch: have %x, want %x", data[:4], method.Id())
+	}
+	var args struct {
+		PublicKey []byte
+		Name      string
+		Email     string
+		Location  string
+		Url       string
+	}
+	if err := method.Inputs.Unpack(&args, data[4:]); err != nil {
+		t.Fatalf("failed to unpack register call: %v", err)
+	}
+	if !bytes.Equal(args.PublicKey, crypto.FromECDSAPub(&key.PublicKey)) {
+		t.Errorf("public key mismatch: have %x", args.PublicKey)
+	}
+	if args.Name != "node" || args.Email != "node@dexon.org" || args.Location != "Taipei" || args.Url != "https://dexon.org" {
+		t.Errorf("node information mismatch: have %+v", args)
+	}
+
+	long := strings.Repeat("x", 32)
+	tests := []struct {
+		name, email, location, url string
+	}{
+		{long, "", "", ""},
+		{"", long, "", ""},
+		{"", "", long, ""},
+		{"", "", "", strings.Repeat("x", 128)},
+	}
+	for i, tt := range tests {
+		if _, err := registerData(&key.PublicKey, tt.name, tt.email, tt.location, tt.url); err == nil {
+			t.Errorf("test %d: over long node information accepted", i)
+		}
+	}
+}
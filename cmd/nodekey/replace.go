@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	dexon "github.com/dexon-foundation/dexon"
+	"github.com/dexon-foundation/dexon/accounts/keystore"
+	"github.com/dexon-foundation/dexon/cmd/utils"
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+var commandReplace = cli.Command{
+	Name:      "replace",
+	Usage:     "rotate the node key of a registered node",
+	ArgsUsage: "<new keyfile> | <new public key>",
+	Description: `
+Replace the public key of a registered node with a new node key by calling
+replaceNodePublicKey of the governance contract.
+
+Without --rpc only the call data is printed. Otherwise the transaction is
+signed with the owner account in the encrypted keystore file given by
+--ownerkey and sent to the node.`,
+	Flags: []cli.Flag{
+		rpcFlag,
+		ownerKeyFlag,
+		passphraseFlag,
+	},
+	Action: func(ctx *cli.Context) error {
+		if len(ctx.Args()) != 1 {
+			utils.Fatalf("The new node key must be specified")
+		}
+		pubKey := loadPublicKey(ctx.Args().First())
+		pubKeyBytes := crypto.FromECDSAPub(pubKey)
+
+		data, err := vm.GovernanceABI.ABI.Pack("replaceNodePublicKey", pubKeyBytes)
+		if err != nil {
+			utils.Fatalf("Failed to pack replaceNodePublicKey call: %v", err)
+		}
+		if ctx.String(rpcFlag.Name) == "" {
+			fmt.Printf("To: %s\n", vm.GovernanceContractAddress.String())
+			fmt.Printf("Data: %s\n", hexutil.Encode(data))
+			return nil
+		}
+
+		// Decrypt the owner key.
+		keyfilepath := ctx.String(ownerKeyFlag.Name)
+		if keyfilepath == "" {
+			utils.Fatalf("No owner keystore specified, use --%s", ownerKeyFlag.Name)
+		}
+		keyjson, err := ioutil.ReadFile(keyfilepath)
+		if err != nil {
+			utils.Fatalf("Failed to read the keyfile at '%s': %v", keyfilepath, err)
+		}
+		key, err := keystore.DecryptKey(keyjson, getPassphrase(ctx))
+		if err != nil {
+			utils.Fatalf("Error decrypting key: %v", err)
+		}
+		owner := key.Address
+
+		rpcClient, client := dialNode(ctx)
+		defer rpcClient.Close()
+
+		bctx := context.Background()
+		chainID, err := client.NetworkID(bctx)
+		if err != nil {
+			utils.Fatalf("Failed to retrieve chain ID: %v", err)
+		}
+		tx, err := replaceTx(client, owner, pubKey)
+		if err != nil {
+			utils.Fatalf("Failed to assemble transaction: %v", err)
+		}
+		tx, err = types.SignTx(tx, types.NewEIP155Signer(chainID), key.PrivateKey)
+		if err != nil {
+			utils.Fatalf("Failed to sign transaction: %v", err)
+		}
+		if err := client.SendTransaction(bctx, tx); err != nil {
+			utils.Fatalf("Failed to send transaction: %v", err)
+		}
+
+		nodeKeyAddress, _ := vm.PublicKeyToNodeKeyAddress(pubKeyBytes)
+		fmt.Printf("Node Key Address: %s\n", nodeKeyAddress.String())
+		fmt.Printf("Transaction: %s\n", tx.Hash().String())
+		return nil
+	},
+}
+
+// replaceTx assembles the unsigned transaction replacing the node key of the
+// node owned by owner with pubKey. The owner must have a node and the new key
+// must not be registered yet.
+func replaceTx(client governanceClient, owner common.Address, pubKey *ecdsa.PublicKey) (*types.Transaction, error) {
+	pubKeyBytes := crypto.FromECDSAPub(pubKey)
+	data, err := vm.GovernanceABI.ABI.Pack("replaceNodePublicKey", pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the owner has a node and the new key is not in use.
+	offset := new(big.Int)
+	if err := callGovernance(client, &offset, "nodesOffsetByAddress", owner); err != nil {
+		return nil, err
+	}
+	if offset.Sign() < 0 {
+		return nil, fmt.Errorf("account %s does not own a node", owner.String())
+	}
+	nodeKeyAddress, err := vm.PublicKeyToNodeKeyAddress(pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := callGovernance(client, &offset, "nodesOffsetByNodeKeyAddress", nodeKeyAddress); err != nil {
+		return nil, err
+	}
+	if offset.Sign() >= 0 {
+		return nil, fmt.Errorf("node key %s is already registered", nodeKeyAddress.String())
+	}
+
+	ctx := context.Background()
+	nonce, err := client.PendingNonceAt(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve nonce: %v", err)
+	}
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve gas price: %v", err)
+	}
+	gas, err := client.EstimateGas(ctx, dexon.CallMsg{
+		From: owner,
+		To:   &vm.GovernanceContractAddress,
+		Data: data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %v", err)
+	}
+	return types.NewTransaction(nonce, vm.GovernanceContractAddress, big.NewInt(0), gas, gasPrice, data), nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/accounts/abi/bind/backends"
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
+)
+
+// sendTx signs a transaction with the homestead signer of the simulated
+// backend and mines it.
+func sendTx(t *testing.T, backend *backends.SimulatedBackend, tx *types.Transaction, key *ecdsa.PrivateKey) {
+	tx, err := types.SignTx(tx, types.HomesteadSigner{}, key)
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+	if err := backend.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("failed to send transaction: %v", err)
+	}
+	backend.Commit()
+
+	receipt, err := backend.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		t.Fatalf("failed to retrieve receipt: %v", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("transaction failed")
+	}
+}
+
+func nodeKeyOffset(t *testing.T, backend *backends.SimulatedBackend, pubKey *ecdsa.PublicKey) int64 {
+	address, err := vm.PublicKeyToNodeKeyAddress(crypto.FromECDSAPub(pubKey))
+	if err != nil {
+		t.Fatalf("failed to derive node key address: %v", err)
+	}
+	offset := new(big.Int)
+	if err := callGovernance(backend, &offset, "nodesOffsetByNodeKeyAddress", address); err != nil {
+		t.Fatalf("failed to query node key: %v", err)
+	}
+	return offset.Int64()
+}
+
+func TestReplaceTx(t *testing.T) {
+	ownerKey, _ := crypto.GenerateKey()
+	nodeKey, _ := crypto.GenerateKey()
+	newKey, _ := crypto.GenerateKey()
+	owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		owner: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))},
+	}, 8000000)
+
+	// Nothing to replace before the owner registers a node.
+	if _, err := replaceTx(backend, owner, &newKey.PublicKey); err == nil {
+		t.Fatalf("replacement of an account without node assembled")
+	}
+
+	data, err := registerData(&nodeKey.PublicKey, "node", "node@dexon.org", "Taipei", "https://dexon.org")
+	if err != nil {
+		t.Fatalf("failed to pack register call: %v", err)
+	}
+	sendTx(t, backend, types.NewTransaction(0, vm.GovernanceContractAddress, big.NewInt(0), 1000000, big.NewInt(1), data), ownerKey)
+	if nodeKeyOffset(t, backend, &nodeKey.PublicKey) < 0 {
+		t.Fatalf("node not registered")
+	}
+
+	// The registered key can't replace itself.
+	if _, err := replaceTx(backend, owner, &nodeKey.PublicKey); err == nil {
+		t.Errorf("replacement with a registered key assembled")
+	}
+
+	tx, err := replaceTx(backend, owner, &newKey.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to assemble replacement: %v", err)
+	}
+	if tx.Nonce() != 1 || *tx.To() != vm.GovernanceContractAddress || tx.Value().Sign() != 0 {
+		t.Errorf("transaction mismatch: nonce %d, to %x, value %v", tx.Nonce(), tx.To(), tx.Value())
+	}
+	sendTx(t, backend, tx, ownerKey)
+
+	if nodeKeyOffset(t, backend, &newKey.PublicKey) < 0 {
+		t.Errorf("new node key not registered")
+	}
+	if nodeKeyOffset(t, backend, &nodeKey.PublicKey) >= 0 {
+		t.Errorf("old node key still registered")
+	}
+	if _, err := replaceTx(backend, common.Address{1}, &nodeKey.PublicKey); err == nil {
+		t.Errorf("replacement by another account assembled")
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/dexon-foundation/dexon/accounts/keystore"
+	"github.com/dexon-foundation/dexon/cmd/utils"
+	"github.com/dexon-foundation/dexon/dex"
+	"github.com/dexon-foundation/dexon/rpc"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+var ipcPathFlag = cli.StringFlag{
+	Name:  "ipcpath",
+	Usage: "path of the IPC endpoint to serve the node key on",
+	Value: "nodekey.ipc",
+}
+
+var commandSigner = cli.Command{
+	Name:      "signer",
+	Usage:     "serve a node key to a node as external signer",
+	ArgsUsage: "<keystore>",
+	Description: `
+Serve the node key in an encrypted keystore file on an IPC endpoint, so a node
+started with --nodekey.signer signs its consensus messages and governance
+transactions without holding the key.`,
+	Flags: []cli.Flag{
+		passphraseFlag,
+		ipcPathFlag,
+	},
+	Action: func(ctx *cli.Context) error {
+		if len(ctx.Args()) != 1 {
+			utils.Fatalf("The node key keystore must be specified")
+		}
+		keyfilepath := ctx.Args().First()
+		keyjson, err := ioutil.ReadFile(keyfilepath)
+		if err != nil {
+			utils.Fatalf("Failed to read the keyfile at '%s': %v", keyfilepath, err)
+		}
+		key, err := keystore.DecryptKey(keyjson, getPassphrase(ctx))
+		if err != nil {
+			utils.Fatalf("Error decrypting key: %v", err)
+		}
+
+		endpoint := ctx.String(ipcPathFlag.Name)
+		listener, server, err := rpc.StartIPCEndpoint(endpoint, []rpc.API{{
+			Namespace: "notary",
+			Version:   "1.0",
+			Service:   dex.NewNotarySignerAPI(key.PrivateKey),
+		}})
+		if err != nil {
+			utils.Fatalf("Failed to start IPC endpoint: %v", err)
+		}
+		defer server.Stop()
+		defer listener.Close()
+
+		fmt.Printf("Node Address: %s\n", key.Address.String())
+		fmt.Printf("Endpoint: %s\n", endpoint)
+
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+		<-sigc
+		return nil
+	},
+}
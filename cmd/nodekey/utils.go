@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	dexon "github.com/dexon-foundation/dexon"
+	"github.com/dexon-foundation/dexon/cmd/utils"
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/console"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/ethclient"
+	"github.com/dexon-foundation/dexon/rpc"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// loadPublicKey reads a node public key given either as a hex encoded
+// uncompressed public key or as the path of a node key file.
+func loadPublicKey(arg string) *ecdsa.PublicKey {
+	pubKey, err := parsePublicKey(arg)
+	if err != nil {
+		utils.Fatalf("Failed to load public key: %v", err)
+	}
+	return pubKey
+}
+
+// parsePublicKey is loadPublicKey returning the failures instead of exiting.
+func parsePublicKey(arg string) (*ecdsa.PublicKey, error) {
+	if arg == "" {
+		arg = defaultKeyfileName
+	}
+	if strings.HasPrefix(arg, "0x") {
+		blob, err := hexutil.Decode(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex encoding: %v", err)
+		}
+		return crypto.UnmarshalPubkey(blob)
+	}
+	privKey, err := crypto.LoadECDSA(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &privKey.PublicKey, nil
+}
+
+// getPassphrase obtains a passphrase given by the user. It first checks the
+// --passwordfile command line flag and ultimately prompts the user for a
+// passphrase.
+func getPassphrase(ctx *cli.Context) string {
+	passphraseFile := ctx.String(passphraseFlag.Name)
+	if passphraseFile != "" {
+		content, err := ioutil.ReadFile(passphraseFile)
+		if err != nil {
+			utils.Fatalf("Failed to read passphrase file '%s': %v",
+				passphraseFile, err)
+		}
+		return strings.TrimRight(string(content), "\r\n")
+	}
+	passphrase, err := console.Stdin.PromptPassword("Passphrase: ")
+	if err != nil {
+		utils.Fatalf("Failed to read passphrase: %v", err)
+	}
+	return passphrase
+}
+
+// dialNode connects to the DEXON node given by the --rpc flag.
+func dialNode(ctx *cli.Context) (*rpc.Client, *ethclient.Client) {
+	endpoint := ctx.String(rpcFlag.Name)
+	if endpoint == "" {
+		utils.Fatalf("No RPC endpoint specified, use --%s", rpcFlag.Name)
+	}
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		utils.Fatalf("Failed to connect to %s: %v", endpoint, err)
+	}
+	return client, ethclient.NewClient(client)
+}
+
+// governanceClient is the part of the node API the governance transactions
+// are assembled with.
+type governanceClient interface {
+	dexon.ContractCaller
+	dexon.GasPricer
+	dexon.GasEstimator
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// callGovernance executes a read only call of the governance contract on the
+// latest state and unpacks the result into out.
+func callGovernance(client dexon.ContractCaller, out interface{}, method string, args ...interface{}) error {
+	input, err := vm.GovernanceABI.ABI.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("failed to pack %s call: %v", method, err)
+	}
+	output, err := client.CallContract(context.Background(), dexon.CallMsg{
+		To:   &vm.GovernanceContractAddress,
+		Data: input,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %v", method, err)
+	}
+	if err := vm.GovernanceABI.ABI.Unpack(out, method, output); err != nil {
+		return fmt.Errorf("failed to unpack %s result: %v", method, err)
+	}
+	return nil
+}
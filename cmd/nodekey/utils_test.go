@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/crypto"
+)
+
+func TestParsePublicKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nodekey-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	keyfile := filepath.Join(dir, "node.key")
+	if err := crypto.SaveECDSA(keyfile, key); err != nil {
+		t.Fatalf("failed to save key: %v", err)
+	}
+	want := crypto.FromECDSAPub(&key.PublicKey)
+
+	for _, arg := range []string{keyfile, hexutil.Encode(want)} {
+		pubKey, err := parsePublicKey(arg)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", arg, err)
+		}
+		if have := crypto.FromECDSAPub(pubKey); !bytes.Equal(have, want) {
+			t.Errorf("public key of %s mismatch: have %x, want %x", arg, have, want)
+		}
+	}
+
+	for _, arg := range []string{
+		"0xzz",
+		hexutil.Encode(want[:32]),
+		filepath.Join(dir, "missing.key"),
+	} {
+		if _, err := parsePublicKey(arg); err == nil {
+			t.Errorf("invalid public key %s accepted", arg)
+		}
+	}
+}
@@ -27,28 +27,31 @@ import (
 	"text/template"
 
 	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/crypto"
 	"github.com/dexon-foundation/dexon/log"
 )
 
-// nodeDockerfile is the Dockerfile required to run an Ethereum node.
+// nodeDockerfile is the Dockerfile required to run a DEXON node.
 var nodeDockerfile = `
-FROM ethereum/client-go:latest
+FROM dexonfoundation/dexon:latest
 
 ADD genesis.json /genesis.json
 {{if .Unlock}}
 	ADD signer.json /signer.json
 	ADD signer.pass /signer.pass
+{{end}}{{if .Notary}}
+	ADD nodekey /nodekey
 {{end}}
 RUN \
   echo 'gdex --cache 512 init /genesis.json' > gdex.sh && \{{if .Unlock}}
-	echo 'mkdir -p /root/.ethereum/keystore/ && cp /signer.json /root/.ethereum/keystore/' >> gdex.sh && \{{end}}
-	echo $'exec gdex --networkid {{.NetworkID}} --cache 512 --port {{.Port}} --nat extip:{{.IP}} --maxpeers {{.Peers}} {{.LightFlag}} --ethstats \'{{.Ethstats}}\' {{if .Bootnodes}}--bootnodes {{.Bootnodes}}{{end}} {{if .Etherbase}}--miner.etherbase {{.Etherbase}} --mine --miner.threads 1{{end}} {{if .Unlock}}--unlock 0 --password /signer.pass --mine{{end}} --miner.gastarget {{.GasTarget}} --miner.gaslimit {{.GasLimit}} --miner.gasprice {{.GasPrice}}' >> gdex.sh
+	echo 'mkdir -p /root/.dexon/keystore/ && cp /signer.json /root/.dexon/keystore/' >> gdex.sh && \{{end}}
+	echo $'exec gdex --networkid {{.NetworkID}} --cache 512 --port {{.Port}} --nat extip:{{.IP}} --maxpeers {{.Peers}} {{.LightFlag}} --ethstats \'{{.Ethstats}}\' {{if .Bootnodes}}--bootnodes {{.Bootnodes}}{{end}} {{if .Etherbase}}--miner.etherbase {{.Etherbase}} --mine --miner.threads 1{{end}} {{if .Unlock}}--unlock 0 --password /signer.pass --mine{{end}} {{if .Notary}}--bp --nodekey /nodekey --recovery.network-rpc {{.Recovery}}{{end}} {{if .GasTarget}}--miner.gastarget {{.GasTarget}} --miner.gaslimit {{.GasLimit}} --miner.gasprice {{.GasPrice}}{{end}}' >> gdex.sh
 
 ENTRYPOINT ["/bin/sh", "gdex.sh"]
 `
 
 // nodeComposefile is the docker-compose.yml file required to deploy and maintain
-// an Ethereum node (bootnode or miner for now).
+// a DEXON node (bootnode or miner for now).
 var nodeComposefile = `
 version: '2'
 services:
@@ -60,7 +63,7 @@ services:
       - "{{.Port}}:{{.Port}}"
       - "{{.Port}}:{{.Port}}/udp"
     volumes:
-      - {{.Datadir}}:/root/.ethereum{{if .Ethashdir}}
+      - {{.Datadir}}:/root/.dexon{{if .Ethashdir}}
       - {{.Ethashdir}}:/root/.ethash{{end}}
     environment:
       - PORT={{.Port}}/tcp
@@ -71,6 +74,7 @@ services:
       - GAS_TARGET={{.GasTarget}}
       - GAS_LIMIT={{.GasLimit}}
       - GAS_PRICE={{.GasPrice}}
+      - RECOVERY_RPC={{.Recovery}}
     logging:
       driver: "json-file"
       options:
@@ -79,12 +83,12 @@ services:
     restart: always
 `
 
-// deployNode deploys a new Ethereum node container to a remote machine via SSH,
+// deployNode deploys a new DEXON node container to a remote machine via SSH,
 // docker and docker-compose. If an instance with the specified network name
 // already exists there, it will be overwritten!
 func deployNode(client *sshClient, network string, bootnodes []string, config *nodeInfos, nocache bool) ([]byte, error) {
 	kind := "sealnode"
-	if config.keyJSON == "" && config.etherbase == "" {
+	if config.keyJSON == "" && config.etherbase == "" && config.nodeKey == "" {
 		kind = "bootnode"
 		bootnodes = make([]string, 0)
 	}
@@ -110,6 +114,8 @@ func deployNode(client *sshClient, network string, bootnodes []string, config *n
 		"GasLimit":  uint64(1000000 * config.gasLimit),
 		"GasPrice":  uint64(1000000000 * config.gasPrice),
 		"Unlock":    config.keyJSON != "",
+		"Notary":    config.nodeKey != "",
+		"Recovery":  config.recovery,
 	})
 	files[filepath.Join(workdir, "Dockerfile")] = dockerfile.Bytes()
 
@@ -128,6 +134,7 @@ func deployNode(client *sshClient, network string, bootnodes []string, config *n
 		"GasTarget":  config.gasTarget,
 		"GasLimit":   config.gasLimit,
 		"GasPrice":   config.gasPrice,
+		"Recovery":   config.recovery,
 	})
 	files[filepath.Join(workdir, "docker-compose.yaml")] = composefile.Bytes()
 
@@ -136,6 +143,9 @@ func deployNode(client *sshClient, network string, bootnodes []string, config *n
 		files[filepath.Join(workdir, "signer.json")] = []byte(config.keyJSON)
 		files[filepath.Join(workdir, "signer.pass")] = []byte(config.keyPass)
 	}
+	if config.nodeKey != "" {
+		files[filepath.Join(workdir, "nodekey")] = []byte(config.nodeKey)
+	}
 	// Upload the deployment files to the remote server (and clean up afterwards)
 	if out, err := client.Upload(files); err != nil {
 		return out, err
@@ -164,6 +174,8 @@ type nodeInfos struct {
 	etherbase  string
 	keyJSON    string
 	keyPass    string
+	nodeKey    string
+	recovery   string
 	gasTarget  float64
 	gasLimit   float64
 	gasPrice   float64
@@ -202,6 +214,15 @@ func (info *nodeInfos) Report() map[string]string {
 			}
 		}
 	}
+	if info.nodeKey != "" {
+		// Dexcon notary proposing blocks
+		if key, err := crypto.HexToECDSA(info.nodeKey); err == nil {
+			report["Notary account"] = crypto.PubkeyToAddress(key.PublicKey).Hex()
+		} else {
+			log.Error("Failed to retrieve notary address", "err", err)
+		}
+		report["Recovery network RPC"] = info.recovery
+	}
 	return report
 }
 
@@ -246,6 +267,10 @@ func checkNode(client *sshClient, network string, boot bool) (*nodeInfos, error)
 	if out, err = client.Run(fmt.Sprintf("docker exec %s_%s_1 cat /signer.pass", network, kind)); err == nil {
 		keyPass = string(bytes.TrimSpace(out))
 	}
+	nodeKey := ""
+	if out, err = client.Run(fmt.Sprintf("docker exec %s_%s_1 cat /nodekey", network, kind)); err == nil {
+		nodeKey = string(bytes.TrimSpace(out))
+	}
 	// Run a sanity check to see if the devp2p is reachable
 	port := infos.portmap[infos.envvars["PORT"]]
 	if err = checkPort(client.server, port); err != nil {
@@ -254,7 +279,7 @@ func checkNode(client *sshClient, network string, boot bool) (*nodeInfos, error)
 	// Assemble and return the useful infos
 	stats := &nodeInfos{
 		genesis:    genesis,
-		datadir:    infos.volumes["/root/.ethereum"],
+		datadir:    infos.volumes["/root/.dexon"],
 		ethashdir:  infos.volumes["/root/.ethash"],
 		port:       port,
 		peersTotal: totalPeers,
@@ -263,6 +288,8 @@ func checkNode(client *sshClient, network string, boot bool) (*nodeInfos, error)
 		etherbase:  infos.envvars["MINER_NAME"],
 		keyJSON:    keyJSON,
 		keyPass:    keyPass,
+		nodeKey:    nodeKey,
+		recovery:   infos.envvars["RECOVERY_RPC"],
 		gasTarget:  gasTarget,
 		gasLimit:   gasLimit,
 		gasPrice:   gasPrice,
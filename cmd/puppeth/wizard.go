@@ -18,6 +18,7 @@ package main
 
 import (
 	"bufio"
+	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -33,6 +34,7 @@ import (
 
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/crypto"
 	"github.com/dexon-foundation/dexon/log"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -329,6 +331,30 @@ func (w *wizard) readDefaultAddress(def common.Address) common.Address {
 	}
 }
 
+// readNodeKey reads a single line from stdin, trimming if from spaces and
+// interpreting it as either a hex encoded node key or the path to a node key
+// file. If an empty line is entered, nil is returned.
+func (w *wizard) readNodeKey() *ecdsa.PrivateKey {
+	for {
+		fmt.Printf("> ")
+		text, err := w.in.ReadString('\n')
+		if err != nil {
+			log.Crit("Failed to read user input", "err", err)
+		}
+		if text = strings.TrimSpace(text); text == "" {
+			return nil
+		}
+		key, err := crypto.HexToECDSA(strings.TrimPrefix(text, "0x"))
+		if err != nil {
+			if key, err = crypto.LoadECDSA(text); err != nil {
+				log.Error("Invalid node key, expected hex key or key file", "err", err)
+				continue
+			}
+		}
+		return key
+	}
+}
+
 // readJSON reads a raw JSON message and returns it.
 func (w *wizard) readJSON() string {
 	var blob json.RawMessage
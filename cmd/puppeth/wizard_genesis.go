@@ -31,6 +31,7 @@ import (
 
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/crypto"
 	"github.com/dexon-foundation/dexon/log"
 	"github.com/dexon-foundation/dexon/params"
 )
@@ -54,9 +55,10 @@ func (w *wizard) makeGenesis() {
 	}
 	// Figure out which consensus engine to choose
 	fmt.Println()
-	fmt.Println("Which consensus engine to use? (default = clique)")
+	fmt.Println("Which consensus engine to use? (default = dexcon)")
 	fmt.Println(" 1. Ethash - proof-of-work")
 	fmt.Println(" 2. Clique - proof-of-authority")
+	fmt.Println(" 3. Dexcon - DEXON byzantine agreement")
 
 	choice := w.read()
 	switch {
@@ -65,7 +67,7 @@ func (w *wizard) makeGenesis() {
 		genesis.Config.Ethash = new(params.EthashConfig)
 		genesis.ExtraData = make([]byte, 32)
 
-	case choice == "" || choice == "2":
+	case choice == "2":
 		// In the case of clique, configure the consensus parameters
 		genesis.Difficulty = big.NewInt(1)
 		genesis.Config.Clique = &params.CliqueConfig{
@@ -103,18 +105,31 @@ func (w *wizard) makeGenesis() {
 			copy(genesis.ExtraData[32+i*common.AddressLength:], signer[:])
 		}
 
+	case choice == "" || choice == "3":
+		// In the case of dexcon, configure the consensus parameters and notaries
+		w.makeDexconGenesis(genesis)
+
 	default:
 		log.Crit("Invalid consensus engine choice", "choice", choice)
 	}
 	// Consensus all set, just ask for initial funds and go
+	balance := new(big.Int).Lsh(big.NewInt(1), 256-7) // 2^256 / 128 (allow many pre-funds without balance overflows)
+	if genesis.Config.Dexcon != nil {
+		// The total supply of DEXON is capped by the halving schedule
+		fmt.Println()
+		fmt.Println("How many DXN should each pre-funded account hold? (default = 1000000)")
+		balance = new(big.Int).Mul(big.NewInt(params.Ether), big.NewInt(int64(w.readDefaultInt(1000000))))
+	}
 	fmt.Println()
 	fmt.Println("Which accounts should be pre-funded? (advisable at least one)")
 	for {
 		// Read the address of the account to fund
 		if address := w.readAddress(); address != nil {
-			genesis.Alloc[*address] = core.GenesisAccount{
-				Balance: new(big.Int).Lsh(big.NewInt(1), 256-7), // 2^256 / 128 (allow many pre-funds without balance overflows)
+			if _, ok := genesis.Alloc[*address]; ok {
+				log.Error("Account already allocated, please retry")
+				continue
 			}
+			genesis.Alloc[*address] = newGenesisAccount(genesis, balance)
 			continue
 		}
 		break
@@ -124,7 +139,7 @@ func (w *wizard) makeGenesis() {
 	if w.readDefaultYesNo(true) {
 		// Add a batch of precompile balances to avoid them getting deleted
 		for i := int64(0); i < 256; i++ {
-			genesis.Alloc[common.BigToAddress(big.NewInt(i))] = core.GenesisAccount{Balance: big.NewInt(1)}
+			genesis.Alloc[common.BigToAddress(big.NewInt(i))] = newGenesisAccount(genesis, big.NewInt(1))
 		}
 	}
 	// Query the user for some custom extras
@@ -139,6 +154,134 @@ func (w *wizard) makeGenesis() {
 	w.conf.flush()
 }
 
+// makeDexconGenesis configures the DEXON consensus parameters of a new genesis
+// block and registers its initial notary nodes based on some user input.
+func (w *wizard) makeDexconGenesis(genesis *core.Genesis) {
+	// Dexcon networks run all the supported forks from the genesis block
+	zero := big.NewInt(0)
+	genesis.Config.HomesteadBlock, genesis.Config.EIP150Block = zero, zero
+	genesis.Config.EIP155Block, genesis.Config.EIP158Block = zero, zero
+	genesis.Config.ByzantiumBlock, genesis.Config.ConstantinopleBlock = zero, zero
+	genesis.Config.PetersburgBlock = zero
+
+	// Start out from the testnet parameters and let the user adjust the essentials
+	config := *params.TestnetChainConfig.Dexcon
+	config.FineValues = append([]*big.Int{}, config.FineValues...)
+
+	dMoment := time.Now().Add(time.Hour).Unix()
+	fmt.Println()
+	fmt.Printf("When should the network start (unix seconds)? (default = %d, one hour from now)\n", dMoment)
+	genesis.Config.DMoment = uint64(w.readDefaultInt(int(dMoment)))
+
+	fmt.Println()
+	fmt.Println("Which account should own the governance contract? (mandatory)")
+	for {
+		if address := w.readAddress(); address != nil {
+			config.Owner = *address
+			break
+		}
+	}
+	fmt.Println()
+	fmt.Println("How many DXN should notaries stake at least? (default = 1000000)")
+	config.MinStake = new(big.Int).Mul(big.NewInt(params.Ether), big.NewInt(int64(w.readDefaultInt(1000000))))
+
+	fmt.Println()
+	fmt.Printf("How many milliseconds should blocks take at least? (default = %d)\n", config.MinBlockInterval)
+	config.MinBlockInterval = uint64(w.readDefaultInt(int(config.MinBlockInterval)))
+
+	fmt.Println()
+	fmt.Printf("How many blocks should a round last? (default = %d)\n", config.RoundLength)
+	config.RoundLength = uint64(w.readDefaultInt(int(config.RoundLength)))
+
+	fmt.Println()
+	fmt.Printf("What gas limit should blocks have (MGas)? (default = %0.3f)\n", float64(config.BlockGasLimit)/1000000)
+	config.BlockGasLimit = uint64(1000000 * w.readDefaultFloat(float64(config.BlockGasLimit)/1000000))
+
+	fmt.Println()
+	fmt.Printf("What gas price should transactions pay at least (GWei)? (default = %0.3f)\n", float64(config.MinGasPrice.Uint64())/params.GWei)
+	config.MinGasPrice = new(big.Int).SetUint64(uint64(params.GWei * w.readDefaultFloat(float64(config.MinGasPrice.Uint64())/params.GWei)))
+
+	genesis.Config.Dexcon = &config
+	genesis.Timestamp = genesis.Config.DMoment * 1000
+	genesis.GasLimit = config.BlockGasLimit
+	genesis.Difficulty = big.NewInt(1)
+	genesis.Nonce = 0x42
+
+	// Stalled notaries vote on the recovery network to restart the chain
+	recovery := *params.TestnetChainConfig.Recovery
+	fmt.Println()
+	fmt.Println("Which contract should notaries vote on for recovery? (default = none)")
+	if address := w.readAddress(); address != nil {
+		recovery.Contract = *address
+	} else {
+		recovery.Contract = common.Address{}
+	}
+	fmt.Println()
+	fmt.Printf("How many seconds without blocks should trigger a recovery? (default = %d)\n", recovery.Timeout)
+	recovery.Timeout = w.readDefaultInt(recovery.Timeout)
+
+	fmt.Println()
+	fmt.Printf("How many confirmations should recovery votes wait for? (default = %d)\n", recovery.Confirmation)
+	recovery.Confirmation = w.readDefaultInt(recovery.Confirmation)
+
+	genesis.Config.Recovery = &recovery
+
+	// We also need the initial notaries, all staked in the governance contract
+	fmt.Println()
+	fmt.Println("How many notaries should be registered at genesis? (default = 4)")
+	notaries := w.readDefaultInt(4)
+	if notaries < 4 {
+		log.Warn("Fewer than 4 notaries cannot tolerate any faulty node", "notaries", notaries)
+	}
+	fmt.Println()
+	fmt.Println("How many DXN should each notary hold besides its stake? (default = 1000)")
+	funds := new(big.Int).Mul(big.NewInt(params.Ether), big.NewInt(int64(w.readDefaultInt(1000))))
+
+	for i := 0; i < notaries; i++ {
+		fmt.Println()
+		fmt.Printf("What's the node key of notary #%d? (hex or key file, default = generate)\n", i)
+		key := w.readNodeKey()
+		if key == nil {
+			var err error
+			if key, err = crypto.GenerateKey(); err != nil {
+				log.Crit("Failed to generate node key", "err", err)
+			}
+			path := filepath.Join(filepath.Dir(w.conf.path), fmt.Sprintf("%s-notary%d.key", w.network, i))
+			if err := crypto.SaveECDSA(path, key); err != nil {
+				log.Crit("Failed to save node key", "path", path, "err", err)
+			}
+			log.Info("Generated notary node key", "path", path)
+		}
+		address := crypto.PubkeyToAddress(key.PublicKey)
+		if _, ok := genesis.Alloc[address]; ok {
+			log.Error("Notary already registered, please retry")
+			i--
+			continue
+		}
+		fmt.Println()
+		fmt.Printf("What should notary #%d be called? (default = Notary %d)\n", i, i)
+		name := w.readDefaultString(fmt.Sprintf("Notary %d", i))
+
+		genesis.Alloc[address] = core.GenesisAccount{
+			Balance:   new(big.Int).Add(config.MinStake, funds),
+			Staked:    config.MinStake,
+			PublicKey: crypto.FromECDSAPub(&key.PublicKey),
+			NodeInfo:  core.NodeInfo{Name: name},
+		}
+		log.Info("Registered notary", "address", address.Hex(), "name", name)
+	}
+}
+
+// newGenesisAccount creates a plain genesis allocation with the given balance,
+// not staked if the genesis is for a Dexcon network.
+func newGenesisAccount(genesis *core.Genesis, balance *big.Int) core.GenesisAccount {
+	account := core.GenesisAccount{Balance: balance}
+	if genesis.Config.Dexcon != nil {
+		account.Staked = big.NewInt(0)
+	}
+	return account
+}
+
 // importGenesis imports a Geth genesis spec into puppeth.
 func (w *wizard) importGenesis() {
 	// Request the genesis JSON spec URL from the user
@@ -238,7 +381,11 @@ func (w *wizard) manageGenesis() {
 		// Save whatever genesis configuration we currently have
 		fmt.Println()
 		fmt.Printf("Which folder to save the genesis specs into? (default = current)\n")
-		fmt.Printf("  Will create %s.json, %s-aleth.json, %s-harmony.json, %s-parity.json\n", w.network, w.network, w.network, w.network)
+		if w.conf.Genesis.Config.Dexcon != nil {
+			fmt.Printf("  Will create %s.json\n", w.network)
+		} else {
+			fmt.Printf("  Will create %s.json, %s-aleth.json, %s-harmony.json, %s-parity.json\n", w.network, w.network, w.network, w.network)
+		}
 
 		folder := w.readDefaultString(".")
 		if err := os.MkdirAll(folder, 0755); err != nil {
@@ -255,6 +402,10 @@ func (w *wizard) manageGenesis() {
 		}
 		log.Info("Saved native genesis chain spec", "path", gethJson)
 
+		// Dexcon networks are only supported by gdex
+		if w.conf.Genesis.Config.Dexcon != nil {
+			return
+		}
 		// Export the genesis spec used by Aleth (formerly C++ Ethereum)
 		if spec, err := newAlethGenesisSpec(w.network, w.conf.Genesis); err != nil {
 			log.Error("Failed to create Aleth chain spec", "err", err)
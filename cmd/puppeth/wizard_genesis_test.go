@@ -0,0 +1,99 @@
+// Copyright 2018 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/crypto"
+)
+
+// Tests that the wizard creates a Dexcon genesis with staked notaries whose
+// generated node keys are saved next to the puppeth configs.
+func TestDexconGenesisWizard(t *testing.T) {
+	dir, err := ioutil.TempDir("", "puppeth-test")
+	if err != nil {
+		t.Fatalf("failed to create temporary dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	owner := common.HexToAddress("0xbf8c48a620bacc46907f9b89732d25e47a2d7cf7")
+	input := []string{
+		// Consensus engine, dMoment and the mandatory owner (empty is retried)
+		"3", "", "", owner.Hex()[2:],
+		// Stake, block interval, round length, gas limit and gas price
+		"", "", "", "", "",
+		// Recovery contract, timeout and confirmations
+		"", "", "",
+		// Notary count and funds, generated keys with default names but the last
+		"4", "", "", "", "", "", "", "", "", "Last Notary",
+		// Pre-fund balance and accounts, no precompiles and the chain ID
+		"", owner.Hex()[2:], "", "no", "237",
+	}
+	w := &wizard{
+		network: "test",
+		conf:    config{path: filepath.Join(dir, "test")},
+		in:      bufio.NewReader(strings.NewReader(strings.Join(input, "\n") + "\n")),
+	}
+	w.makeGenesis()
+
+	genesis := w.conf.Genesis
+	if genesis.Config.Dexcon == nil {
+		t.Fatalf("dexcon config missing")
+	}
+	if genesis.Config.Dexcon.Owner != owner {
+		t.Errorf("owner mismatch: have %x, want %x", genesis.Config.Dexcon.Owner, owner)
+	}
+	if genesis.Timestamp != genesis.Config.DMoment*1000 {
+		t.Errorf("timestamp mismatch: have %d, want %d", genesis.Timestamp, genesis.Config.DMoment*1000)
+	}
+	if len(genesis.Alloc) != 5 {
+		t.Fatalf("allocation count mismatch: have %d, want 5", len(genesis.Alloc))
+	}
+	if staked := genesis.Alloc[owner].Staked; staked == nil || staked.Sign() != 0 {
+		t.Errorf("pre-funded account staked mismatch: have %v, want 0", staked)
+	}
+	for i := 0; i < 4; i++ {
+		key, err := crypto.LoadECDSA(filepath.Join(dir, fmt.Sprintf("test-notary%d.key", i)))
+		if err != nil {
+			t.Fatalf("notary %d: failed to load node key: %v", i, err)
+		}
+		account, ok := genesis.Alloc[crypto.PubkeyToAddress(key.PublicKey)]
+		if !ok {
+			t.Fatalf("notary %d: not allocated", i)
+		}
+		if account.Staked.Cmp(genesis.Config.Dexcon.MinStake) != 0 {
+			t.Errorf("notary %d: staked mismatch: have %v, want %v", i, account.Staked, genesis.Config.Dexcon.MinStake)
+		}
+		name := fmt.Sprintf("Notary %d", i)
+		if i == 3 {
+			name = "Last Notary"
+		}
+		if account.NodeInfo.Name != name {
+			t.Errorf("notary %d: name mismatch: have %q, want %q", i, account.NodeInfo.Name, name)
+		}
+	}
+	// Make sure the genesis state can actually be created
+	genesis.ToBlock(nil)
+}
@@ -17,12 +17,15 @@
 package main
 
 import (
+	"crypto/ecdsa"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/dexon-foundation/dexon/accounts/keystore"
 	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/crypto"
 	"github.com/dexon-foundation/dexon/log"
 )
 
@@ -47,9 +50,12 @@ func (w *wizard) deployNode(boot bool) {
 	// Retrieve any active node configurations from the server
 	infos, err := checkNode(client, w.network, boot)
 	if err != nil {
-		if boot {
+		switch {
+		case boot:
 			infos = &nodeInfos{port: 30303, peersTotal: 512, peersLight: 256}
-		} else {
+		case w.conf.Genesis.Config.Dexcon != nil:
+			infos = &nodeInfos{port: 30303, peersTotal: 50, peersLight: 0, recovery: "https://mainnet.infura.io"}
+		default:
 			infos = &nodeInfos{port: 30303, peersTotal: 50, peersLight: 0, gasTarget: 7.5, gasLimit: 10, gasPrice: 1}
 		}
 	}
@@ -146,19 +152,52 @@ func (w *wizard) deployNode(boot bool) {
 					return
 				}
 			}
+		} else if w.conf.Genesis.Config.Dexcon != nil {
+			// If a previous notary key was already set, offer to reuse it
+			if infos.nodeKey != "" {
+				if key, err := crypto.HexToECDSA(infos.nodeKey); err != nil {
+					infos.nodeKey = ""
+				} else {
+					fmt.Println()
+					fmt.Printf("Reuse previous (%s) notary account (y/n)? (default = yes)\n", crypto.PubkeyToAddress(key.PublicKey).Hex())
+					if !w.readDefaultYesNo(true) {
+						infos.nodeKey = ""
+					}
+				}
+			}
+			// Dexcon notaries need the node key registered in the governance contract
+			if infos.nodeKey == "" {
+				fmt.Println()
+				fmt.Println("What's the node key of the notary? (hex or key file)")
+				var key *ecdsa.PrivateKey
+				for key == nil {
+					key = w.readNodeKey()
+				}
+				if account, ok := w.conf.Genesis.Alloc[crypto.PubkeyToAddress(key.PublicKey)]; !ok || account.Staked == nil || account.Staked.Sign() == 0 {
+					log.Warn("Node key not registered at genesis, stake it in the governance contract to become a notary")
+				}
+				infos.nodeKey = hex.EncodeToString(crypto.FromECDSA(key))
+			}
+			// Stalled notaries need access to the recovery network to vote on
+			fmt.Println()
+			fmt.Printf("What's the RPC endpoint of the recovery network? (default = %s)\n", infos.recovery)
+			infos.recovery = w.readDefaultString(infos.recovery)
 		}
-		// Establish the gas dynamics to be enforced by the signer
-		fmt.Println()
-		fmt.Printf("What gas limit should empty blocks target (MGas)? (default = %0.3f)\n", infos.gasTarget)
-		infos.gasTarget = w.readDefaultFloat(infos.gasTarget)
+		// Establish the gas dynamics to be enforced by the signer, Dexcon
+		// configures them in the governance contract instead
+		if w.conf.Genesis.Config.Dexcon == nil {
+			fmt.Println()
+			fmt.Printf("What gas limit should empty blocks target (MGas)? (default = %0.3f)\n", infos.gasTarget)
+			infos.gasTarget = w.readDefaultFloat(infos.gasTarget)
 
-		fmt.Println()
-		fmt.Printf("What gas limit should full blocks target (MGas)? (default = %0.3f)\n", infos.gasLimit)
-		infos.gasLimit = w.readDefaultFloat(infos.gasLimit)
+			fmt.Println()
+			fmt.Printf("What gas limit should full blocks target (MGas)? (default = %0.3f)\n", infos.gasLimit)
+			infos.gasLimit = w.readDefaultFloat(infos.gasLimit)
 
-		fmt.Println()
-		fmt.Printf("What gas price should the signer require (GWei)? (default = %0.3f)\n", infos.gasPrice)
-		infos.gasPrice = w.readDefaultFloat(infos.gasPrice)
+			fmt.Println()
+			fmt.Printf("What gas price should the signer require (GWei)? (default = %0.3f)\n", infos.gasPrice)
+			infos.gasPrice = w.readDefaultFloat(infos.gasPrice)
+		}
 	}
 	// Try to deploy the full node on the host
 	nocache := false
@@ -168,7 +207,7 @@ func (w *wizard) deployNode(boot bool) {
 		nocache = w.readDefaultYesNo(false)
 	}
 	if out, err := deployNode(client, w.network, w.conf.bootnodes, infos, nocache); err != nil {
-		log.Error("Failed to deploy Ethereum node container", "err", err)
+		log.Error("Failed to deploy DEXON node container", "err", err)
 		if len(out) > 0 {
 			fmt.Printf("%s\n", out)
 		}
@@ -0,0 +1,218 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package utils
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/dexon-foundation/dexon/accounts"
+	"github.com/dexon-foundation/dexon/accounts/keystore"
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/dex"
+	"github.com/dexon-foundation/dexon/log"
+	"github.com/dexon-foundation/dexon/node"
+	"github.com/dexon-foundation/dexon/p2p"
+	"github.com/dexon-foundation/dexon/rpc"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// developerKey returns the private key of the developer account.
+func developerKey(ks *keystore.KeyStore, developer accounts.Account) *ecdsa.PrivateKey {
+	keyjson, err := ks.Export(developer, "", "")
+	if err != nil {
+		Fatalf("Failed to export developer account: %v", err)
+	}
+	key, err := keystore.DecryptKey(keyjson, "")
+	if err != nil {
+		Fatalf("Failed to decrypt developer account: %v", err)
+	}
+	return key.PrivateKey
+}
+
+// developerNodeKey derives the key of an in-process notary of the developer
+// network from the developer account, so that a restarted network keeps its
+// notaries.
+func developerNodeKey(developer *ecdsa.PrivateKey, i int) *ecdsa.PrivateKey {
+	seed := crypto.Keccak256(crypto.FromECDSA(developer), []byte(fmt.Sprintf("devnode%d", i)))
+	key, err := crypto.ToECDSA(seed)
+	if err != nil {
+		Fatalf("Failed to derive developer node key: %v", err)
+	}
+	return key
+}
+
+// developerNodeDir returns the data directory of an in-process notary of the
+// developer network, or an empty string if the network is ephemeral.
+func developerNodeDir(stack *node.Node, i int) string {
+	return stack.ResolvePath(filepath.Join("devnodes", fmt.Sprintf("node%d", i)))
+}
+
+// developerNodeCount returns the number of notaries of the developer network.
+// An existing network keeps the notaries it was created with.
+func developerNodeCount(ctx *cli.Context, stack *node.Node) int {
+	if dir := developerNodeDir(stack, 1); dir == "" || !common.FileExist(dir) {
+		return ctx.GlobalInt(DeveloperNodesFlag.Name)
+	}
+	n := 0
+	for common.FileExist(developerNodeDir(stack, n+1)) {
+		n++
+	}
+	return n
+}
+
+// RegisterDeveloperNodes adds the in-process notaries of the developer
+// network to the stack. They are started with the stack and connected to it.
+func RegisterDeveloperNodes(ctx *cli.Context, stack *node.Node, cfg *dex.Config) {
+	ks := stack.AccountManager().Backends(keystore.KeyStoreType)[0].(*keystore.KeyStore)
+	nodes := &developerNodes{
+		stack:     stack,
+		config:    cfg,
+		developer: developerKey(ks, ks.Accounts()[0]),
+		count:     developerNodeCount(ctx, stack),
+		recovery:  &developerRecovery{votes: make(map[uint64]map[int]struct{})},
+	}
+	if err := stack.Register(func(*node.ServiceContext) (node.Service, error) {
+		return nodes, nil
+	}); err != nil {
+		Fatalf("Failed to register the developer nodes: %v", err)
+	}
+}
+
+// developerRecovery counts the recovery votes of the in-process notaries, it
+// replaces the recovery network which resumes a halted chain, e.g. a developer
+// network started again.
+type developerRecovery struct {
+	lock  sync.Mutex
+	votes map[uint64]map[int]struct{}
+}
+
+// voter returns the recovery of the i-th notary.
+func (r *developerRecovery) voter(i int) *developerRecoveryVoter {
+	return &developerRecoveryVoter{recovery: r, id: i}
+}
+
+type developerRecoveryVoter struct {
+	recovery *developerRecovery
+	id       int
+}
+
+// ProposeSkipBlock votes to resume the chain at the given height.
+func (v *developerRecoveryVoter) ProposeSkipBlock(height uint64) error {
+	v.recovery.lock.Lock()
+	defer v.recovery.lock.Unlock()
+
+	if v.recovery.votes[height] == nil {
+		v.recovery.votes[height] = make(map[int]struct{})
+	}
+	v.recovery.votes[height][v.id] = struct{}{}
+	return nil
+}
+
+// Votes returns the number of notaries voted to resume the chain at the given
+// height.
+func (v *developerRecoveryVoter) Votes(height uint64) (uint64, error) {
+	v.recovery.lock.Lock()
+	defer v.recovery.lock.Unlock()
+
+	return uint64(len(v.recovery.votes[height])), nil
+}
+
+// developerNodes is a service running the in-process notaries of the
+// developer network, the developer node itself only follows their chain.
+type developerNodes struct {
+	stack     *node.Node
+	config    *dex.Config
+	developer *ecdsa.PrivateKey
+	count     int
+	recovery  *developerRecovery
+	nodes     []*node.Node
+}
+
+func (d *developerNodes) Protocols() []p2p.Protocol { return nil }
+func (d *developerNodes) APIs() []rpc.API           { return nil }
+
+// Start starts the notaries and connects them to each other and to the
+// developer node. A notary is identified by its peer key, so each one runs
+// its own p2p server with the key it is registered with.
+func (d *developerNodes) Start(srv *p2p.Server) error {
+	for i := 1; i <= d.count; i++ {
+		key := developerNodeKey(d.developer, i)
+		stack, err := node.New(&node.Config{
+			Name:    "gdex",
+			DataDir: developerNodeDir(d.stack, i),
+			P2P: p2p.Config{
+				PrivateKey:  key,
+				MaxPeers:    d.count,
+				ListenAddr:  "127.0.0.1:0",
+				NoDiscovery: true,
+			},
+			NoUSB:  true,
+			Logger: log.New("devnode", i),
+		})
+		if err != nil {
+			d.Stop()
+			return err
+		}
+		config := *d.config
+		config.PrivateKey = key
+		config.BlockProposerEnabled = true
+		config.Recovery = d.recovery.voter(i)
+		config.DatabaseCache = 16
+		config.TrieCleanCache = 16
+		config.TrieDirtyCache = 16
+		config.DatabaseFreezer = ""
+		config.TxPool.Journal = ""
+		if err := stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
+			return dex.New(ctx, &config)
+		}); err != nil {
+			d.Stop()
+			return err
+		}
+		if err := stack.Start(); err != nil {
+			d.Stop()
+			return err
+		}
+		d.nodes = append(d.nodes, stack)
+	}
+	for _, stack := range d.nodes {
+		self := stack.Server().Self()
+		srv.AddTrustedPeer(self)
+		srv.AddPeer(self)
+		for _, other := range d.nodes {
+			if other != stack {
+				other.Server().AddPeer(self)
+			}
+		}
+	}
+	log.Info("Started developer nodes", "count", len(d.nodes))
+	return nil
+}
+
+// Stop stops the notaries.
+func (d *developerNodes) Stop() error {
+	for _, stack := range d.nodes {
+		if err := stack.Stop(); err != nil {
+			log.Warn("Failed to stop developer node", "err", err)
+		}
+	}
+	d.nodes = nil
+	return nil
+}
@@ -35,7 +35,9 @@ import (
 	"github.com/dexon-foundation/dexon/consensus"
 	"github.com/dexon-foundation/dexon/consensus/clique"
 	"github.com/dexon-foundation/dexon/consensus/ethash"
+	"github.com/dexon-foundation/dexon/console"
 	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/core/rawdb"
 	"github.com/dexon-foundation/dexon/core/state"
 	"github.com/dexon-foundation/dexon/core/vm"
 	"github.com/dexon-foundation/dexon/crypto"
@@ -124,6 +126,15 @@ var (
 		Name:  "keystore",
 		Usage: "Directory for the keystore (default = inside the datadir)",
 	}
+	AncientFlag = DirectoryFlag{
+		Name:  "datadir.ancient",
+		Usage: "Data directory for the ancient store of old blocks and receipts (disabled if empty)",
+	}
+	DBEngineFlag = cli.StringFlag{
+		Name:  "db.engine",
+		Usage: "Key-value store engine of the databases (" + strings.Join(ethdb.Engines(), ", ") + ")",
+		Value: ethdb.EngineLevelDB,
+	}
 	NoUSBFlag = cli.BoolFlag{
 		Name:  "nousb",
 		Usage: "Disables monitoring for and managing USB hardware wallets",
@@ -151,11 +162,16 @@ var (
 	}
 	DeveloperFlag = cli.BoolFlag{
 		Name:  "dev",
-		Usage: "Ephemeral proof-of-authority network with a pre-funded developer account, mining enabled",
+		Usage: "Ephemeral DEXON network of in-process notaries with a pre-funded developer account, block proposing enabled",
 	}
 	DeveloperPeriodFlag = cli.IntFlag{
 		Name:  "dev.period",
-		Usage: "Block period to use in developer mode (0 = mine only if transaction pending)",
+		Usage: "Block period to use in developer mode (0 = propose only if transaction pending)",
+	}
+	DeveloperNodesFlag = cli.IntFlag{
+		Name:  "dev.nodes",
+		Usage: "Number of in-process notaries to run in developer mode (at least 3)",
+		Value: 4,
 	}
 	IdentityFlag = cli.StringFlag{
 		Name:  "identity",
@@ -177,6 +193,19 @@ var (
 		Usage: `Blockchain garbage collection mode ("full", "archive")`,
 		Value: "full",
 	}
+	GCCoreRoundsFlag = cli.Uint64Flag{
+		Name:  "gcmode.corerounds",
+		Usage: "Number of recent rounds of finalized consensus core blocks to keep (0 = keep all)",
+	}
+	GCStateBlocksFlag = cli.Uint64Flag{
+		Name:  "gcmode.stateblocks",
+		Usage: "Prune historical state at round changes, keeping round start states and the given number of recent block states (0 = disabled)",
+	}
+	GCStateBloomFlag = cli.Uint64Flag{
+		Name:  "gcmode.statebloom",
+		Usage: "Megabytes of memory allocated to the bloom filter of state pruning",
+		Value: dex.DefaultConfig.StatePruneBloom,
+	}
 	LightServFlag = cli.IntFlag{
 		Name:  "lightserv",
 		Usage: "Maximum percentage of time allowed for serving LES requests (0-90)",
@@ -544,6 +573,18 @@ var (
 		Name:  "nodekeyhex",
 		Usage: "P2P node key as hex (for testing)",
 	}
+	NodeKeyKeystoreFlag = cli.StringFlag{
+		Name:  "nodekey.keystore",
+		Usage: "P2P node key as encrypted keystore file",
+	}
+	NodeKeyPasswordFlag = cli.StringFlag{
+		Name:  "nodekey.password",
+		Usage: "Password file to decrypt the node key keystore",
+	}
+	NodeKeySignerFlag = cli.StringFlag{
+		Name:  "nodekey.signer",
+		Usage: "External signer endpoint (IPC path) signing consensus messages and governance transactions with the node key (the p2p node key must be the same key)",
+	}
 	NATFlag = cli.StringFlag{
 		Name:  "nat",
 		Usage: "NAT port mapping mechanism (any|none|upnp|pmp|extip:<IP>)",
@@ -694,18 +735,26 @@ func MakeDataDir(ctx *cli.Context) string {
 }
 
 // setNodeKey creates a node key from set command line flags, either loading it
-// from a file or as a specified hex value. If neither flags were provided, this
-// method returns nil and an emphemeral key is to be generated.
+// from a plain or encrypted file or as a specified hex value. If neither flags
+// were provided, this method returns nil and an emphemeral key is to be generated.
 func setNodeKey(ctx *cli.Context, cfg *p2p.Config) {
 	var (
-		hex  = ctx.GlobalString(NodeKeyHexFlag.Name)
-		file = ctx.GlobalString(NodeKeyFileFlag.Name)
-		key  *ecdsa.PrivateKey
-		err  error
+		hex   = ctx.GlobalString(NodeKeyHexFlag.Name)
+		file  = ctx.GlobalString(NodeKeyFileFlag.Name)
+		keyks = ctx.GlobalString(NodeKeyKeystoreFlag.Name)
+		key   *ecdsa.PrivateKey
+		err   error
 	)
 	switch {
 	case file != "" && hex != "":
 		Fatalf("Options %q and %q are mutually exclusive", NodeKeyFileFlag.Name, NodeKeyHexFlag.Name)
+	case keyks != "" && (file != "" || hex != ""):
+		Fatalf("Option %q is mutually exclusive with %q and %q", NodeKeyKeystoreFlag.Name, NodeKeyFileFlag.Name, NodeKeyHexFlag.Name)
+	case keyks != "":
+		if key, err = loadNodeKeystore(ctx, keyks); err != nil {
+			Fatalf("Option %q: %v", NodeKeyKeystoreFlag.Name, err)
+		}
+		cfg.PrivateKey = key
 	case file != "":
 		if key, err = crypto.LoadECDSA(file); err != nil {
 			Fatalf("Option %q: %v", NodeKeyFileFlag.Name, err)
@@ -719,6 +768,32 @@ func setNodeKey(ctx *cli.Context, cfg *p2p.Config) {
 	}
 }
 
+// loadNodeKeystore decrypts the node key stored in an encrypted keystore file,
+// with the passphrase read from the password file flag or prompted for.
+func loadNodeKeystore(ctx *cli.Context, file string) (*ecdsa.PrivateKey, error) {
+	keyjson, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var passphrase string
+	if path := ctx.GlobalString(NodeKeyPasswordFlag.Name); path != "" {
+		text, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read password file: %v", err)
+		}
+		passphrase = strings.TrimRight(strings.Split(string(text), "\n")[0], "\r")
+	} else {
+		if passphrase, err = console.Stdin.PromptPassword("Node key passphrase: "); err != nil {
+			return nil, fmt.Errorf("failed to read passphrase: %v", err)
+		}
+	}
+	key, err := keystore.DecryptKey(keyjson, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return key.PrivateKey, nil
+}
+
 // setNodeUserIdent creates the user identifier from CLI flags.
 func setNodeUserIdent(ctx *cli.Context, cfg *node.Config) {
 	if identity := ctx.GlobalString(IdentityFlag.Name); len(identity) > 0 {
@@ -1010,7 +1085,8 @@ func SetP2PConfig(ctx *cli.Context, cfg *p2p.Config) {
 	}
 
 	if ctx.GlobalBool(DeveloperFlag.Name) {
-		// --dev mode can't use p2p networking.
+		// --dev mode can't use p2p networking, apart from connecting the
+		// in-process notaries as trusted peers.
 		cfg.MaxPeers = 0
 		cfg.ListenAddr = ":0"
 		cfg.NoDiscovery = true
@@ -1036,6 +1112,9 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	if ctx.GlobalIsSet(NoUSBFlag.Name) {
 		cfg.NoUSB = ctx.GlobalBool(NoUSBFlag.Name)
 	}
+	if ctx.GlobalIsSet(DBEngineFlag.Name) {
+		cfg.DBEngine = ctx.GlobalString(DBEngineFlag.Name)
+	}
 }
 
 func setDataDir(ctx *cli.Context, cfg *node.Config) {
@@ -1203,6 +1282,16 @@ func SetShhConfig(ctx *cli.Context, stack *node.Node, cfg *whisper.Config) {
 	}
 }
 
+const (
+	// developerDMomentDelay is the time given to the developer nodes to
+	// start up before their consensus begins.
+	developerDMomentDelay = 10 * time.Second
+
+	// developerOnDemandInterval is the minimum block interval in
+	// milliseconds of a developer network proposing blocks on demand.
+	developerOnDemandInterval = 500
+)
+
 // SetDexConfig applies eth-related command line flags to the config.
 func SetDexConfig(ctx *cli.Context, stack *node.Node, cfg *dex.Config) {
 	// Avoid conflicting network flags
@@ -1229,17 +1318,33 @@ func SetDexConfig(ctx *cli.Context, stack *node.Node, cfg *dex.Config) {
 	if ctx.GlobalIsSet(BlockProposerEnabledFlag.Name) {
 		cfg.BlockProposerEnabled = ctx.GlobalBool(BlockProposerEnabledFlag.Name)
 	}
+	if ctx.GlobalIsSet(NodeKeySignerFlag.Name) {
+		cfg.ExternalSigner = ctx.GlobalString(NodeKeySignerFlag.Name)
+	}
 
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheDatabaseFlag.Name) {
 		cfg.DatabaseCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheDatabaseFlag.Name) / 100
 	}
 	cfg.DatabaseHandles = makeDatabaseHandles()
+	if ctx.GlobalIsSet(AncientFlag.Name) {
+		cfg.DatabaseFreezer = ctx.GlobalString(AncientFlag.Name)
+	}
 
 	if gcmode := ctx.GlobalString(GCModeFlag.Name); gcmode != "full" && gcmode != "archive" {
 		Fatalf("--%s must be either 'full' or 'archive'", GCModeFlag.Name)
 	}
 	cfg.NoPruning = ctx.GlobalString(GCModeFlag.Name) == "archive"
 
+	if ctx.GlobalIsSet(GCCoreRoundsFlag.Name) {
+		cfg.CorePruneRounds = ctx.GlobalUint64(GCCoreRoundsFlag.Name)
+	}
+	if ctx.GlobalIsSet(GCStateBlocksFlag.Name) {
+		cfg.StatePruneBlocks = ctx.GlobalUint64(GCStateBlocksFlag.Name)
+	}
+	if ctx.GlobalIsSet(GCStateBloomFlag.Name) {
+		cfg.StatePruneBloom = ctx.GlobalUint64(GCStateBloomFlag.Name)
+	}
+
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheTrieFlag.Name) {
 		cfg.TrieCleanCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheTrieFlag.Name) / 100
 	}
@@ -1319,7 +1424,45 @@ func SetDexConfig(ctx *cli.Context, stack *node.Node, cfg *dex.Config) {
 		}
 		log.Info("Using developer account", "address", developer.Address)
 
-		cfg.Genesis = core.DeveloperGenesisBlock(uint64(ctx.GlobalInt(DeveloperPeriodFlag.Name)), developer.Address)
+		// The developer node follows the chain of the in-process notaries,
+		// whose keys are derived from the developer account.
+		key := developerKey(ks, developer)
+
+		period := uint64(ctx.GlobalInt(DeveloperPeriodFlag.Name))
+		cfg.ProposeOnDemand = period == 0
+
+		// A network of less than 3 notaries can't run the DKG.
+		nodes := developerNodeCount(ctx, stack)
+		if nodes < 3 {
+			Fatalf("Developer mode requires at least 3 notaries, got %d", nodes)
+		}
+		// Reuse an existing developer chain, the genesis is already stored
+		// by all the notaries.
+		if ctx.GlobalIsSet(DataDirFlag.Name) {
+			chainDb := MakeChainDatabase(ctx, stack)
+			initialized := rawdb.ReadCanonicalHash(chainDb, 0) != (common.Hash{})
+			chainDb.Close()
+			if initialized {
+				if !common.FileExist(developerNodeDir(stack, 1)) {
+					Fatalf("Developer chain has no in-process notaries, remove %s to start a new one", stack.ResolvePath("chaindata"))
+				}
+				if ctx.GlobalIsSet(DeveloperNodesFlag.Name) && ctx.GlobalInt(DeveloperNodesFlag.Name) != nodes {
+					log.Warn("Ignoring notary count of existing developer chain", "nodes", nodes)
+				}
+				log.Info("Reusing existing developer chain", "nodes", nodes)
+				break
+			}
+		}
+		interval := period * 1000
+		if period == 0 {
+			interval = developerOnDemandInterval
+		}
+		var notaries []*ecdsa.PublicKey
+		for i := 1; i <= nodes; i++ {
+			notaries = append(notaries, &developerNodeKey(key, i).PublicKey)
+		}
+		dMoment := uint64(time.Now().Add(developerDMomentDelay).Unix())
+		cfg.Genesis = core.DexconDeveloperGenesisBlock(interval, dMoment, &key.PublicKey, notaries...)
 	}
 	// TODO(fjl): move trie cache generations into config
 	if gen := ctx.GlobalInt(TrieCacheGenFlag.Name); gen > 0 {
@@ -1377,7 +1520,10 @@ func RegisterDexService(stack *node.Node, cfg *dex.Config) {
 // RegisterDashboardService adds a dashboard to the stack.
 func RegisterDashboardService(stack *node.Node, cfg *dashboard.Config, commit string) {
 	stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
-		return dashboard.New(cfg, commit, ctx.ResolvePath("logs")), nil
+		var dexServ *dex.Dexon
+		ctx.Service(&dexServ)
+
+		return dashboard.New(cfg, commit, ctx.ResolvePath("logs"), dexServ), nil
 	})
 }
 
@@ -1394,14 +1540,17 @@ func RegisterShhService(stack *node.Node, cfg *whisper.Config) {
 // the given node.
 func RegisterEthStatsService(stack *node.Node, url string) {
 	if err := stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
-		// Retrieve both eth and les services
+		// Retrieve the eth, les and dex services
 		var ethServ *eth.Ethereum
 		ctx.Service(&ethServ)
 
 		var lesServ *les.LightEthereum
 		ctx.Service(&lesServ)
 
-		return ethstats.New(url, ethServ, lesServ)
+		var dexServ *dex.Dexon
+		ctx.Service(&dexServ)
+
+		return ethstats.New(url, ethServ, lesServ, dexServ)
 	}); err != nil {
 		Fatalf("Failed to register the Ethereum Stats service: %v", err)
 	}
@@ -1459,6 +1608,12 @@ func MakeChainDatabase(ctx *cli.Context, stack *node.Node) ethdb.Database {
 	if err != nil {
 		Fatalf("Could not open database: %v", err)
 	}
+	if ctx.GlobalIsSet(AncientFlag.Name) && name == "chaindata" {
+		chainDb, err = rawdb.NewDatabaseWithFreezer(chainDb, stack.ResolvePath(ctx.GlobalString(AncientFlag.Name)))
+		if err != nil {
+			Fatalf("Could not open ancient database: %v", err)
+		}
+	}
 	return chainDb
 }
 
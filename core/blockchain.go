@@ -40,6 +40,7 @@ import (
 	"github.com/dexon-foundation/dexon/consensus/dexcon"
 	"github.com/dexon-foundation/dexon/core/rawdb"
 	"github.com/dexon-foundation/dexon/core/state"
+	"github.com/dexon-foundation/dexon/core/state/pruner"
 	"github.com/dexon-foundation/dexon/core/types"
 	"github.com/dexon-foundation/dexon/core/vm"
 	"github.com/dexon-foundation/dexon/crypto"
@@ -81,6 +82,9 @@ type CacheConfig struct {
 	TrieCleanLimit int           // Memory allowance (MB) to use for caching trie nodes in memory
 	TrieDirtyLimit int           // Memory limit (MB) at which to start flushing dirty trie nodes to disk
 	TrieTimeLimit  time.Duration // Time limit after which to flush the current in-memory trie to disk
+
+	StatePruneBlocks uint64 // Number of recent block states kept by online state pruning (0 = disabled)
+	StatePruneBloom  uint64 // Memory allowance (MB) of the bloom filter used by state pruning
 }
 
 // BlockChain represents the canonical chain given a database with a genesis
@@ -146,6 +150,8 @@ type BlockChain struct {
 
 	roundHeightMap sync.Map
 
+	pruner *pruner.Pruner // Online state pruner, nil if disabled
+
 	gov             *Governance
 	verifierCache   *dexCore.TSigVerifierCache
 	nextTouchHeight uint64
@@ -169,12 +175,23 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	futureBlocks, _ := lru.New(maxFutureBlocks)
 	badBlocks, _ := lru.New(badBlockLimit)
 
+	// Commit the state through the pruner when pruning online, so that state
+	// written while pruning is never swept.
+	var (
+		statePruner *pruner.Pruner
+		stateDb     = db
+	)
+	if cacheConfig.StatePruneBlocks > 0 {
+		statePruner = pruner.NewPruner(db, cacheConfig.StatePruneBloom*1024*1024)
+		stateDb = statePruner.Database()
+	}
+
 	bc := &BlockChain{
 		chainConfig:   chainConfig,
 		cacheConfig:   cacheConfig,
 		db:            db,
 		triegc:        prque.New(nil),
-		stateCache:    state.NewDatabaseWithCache(db, cacheConfig.TrieCleanLimit),
+		stateCache:    state.NewDatabaseWithCache(stateDb, cacheConfig.TrieCleanLimit),
 		quit:          make(chan struct{}),
 		bodyCache:     bodyCache,
 		bodyRLPCache:  bodyRLPCache,
@@ -184,6 +201,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 		engine:        engine,
 		vmConfig:      vmConfig,
 		badBlocks:     badBlocks,
+		pruner:        statePruner,
 	}
 	bc.SetValidator(NewBlockValidator(chainConfig, bc, engine))
 	bc.SetProcessor(NewStateProcessor(chainConfig, bc, engine))
@@ -352,6 +370,14 @@ func (bc *BlockChain) SetHead(head uint64) error {
 	bc.hc.SetHead(head, delFn)
 	currentHeader := bc.hc.CurrentHeader()
 
+	// Discard the frozen blocks above the new head, the ancient store must not
+	// hold blocks the rewound chain does not contain.
+	if ancients, ok := bc.db.(rawdb.AncientWriter); ok {
+		if err := ancients.TruncateAncients(currentHeader.Number.Uint64() + 1); err != nil {
+			return err
+		}
+	}
+
 	// Clear out any stale content from the caches
 	bc.bodyCache.Purge()
 	bc.bodyRLPCache.Purge()
@@ -1020,6 +1046,9 @@ func (bc *BlockChain) WriteBlockWithState(block *types.Block, receipts []*types.
 
 	// Write gov state into disk
 	if height == block.NumberU64() {
+		gs := &vm.GovernanceState{StateDB: statedb}
+		rawdb.WriteGovernanceSnapshot(bc.db, gs.Snapshot(block.Round(), height))
+
 		// spawn a goroutine to write gov state
 		go func() {
 			retry := 3
@@ -1050,6 +1079,9 @@ func (bc *BlockChain) WriteBlockWithState(block *types.Block, receipts []*types.
 		if err := triedb.Commit(root, false); err != nil {
 			return NonStatTy, err
 		}
+		if bc.pruner != nil && height == block.NumberU64() {
+			bc.pruneState(block)
+		}
 	} else {
 		// Full but not archive node, do proper garbage collection
 		triedb.Reference(root, common.Hash{}) // metadata reference to keep trie alive
@@ -1757,6 +1789,8 @@ func (bc *BlockChain) processBlock(
 		return nil, nil, nil, err
 	}
 
+	vm.ActivateOracleContracts(bc.chainConfig, header.Number, currentState)
+
 	// Iterate over and process the individual transactions.
 	for i, tx := range block.Transactions() {
 		currentState.Prepare(tx.Hash(), block.Hash(), i)
@@ -2339,6 +2373,110 @@ func (bc *BlockChain) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscript
 	return bc.scope.Track(bc.logsFeed.Subscribe(ch))
 }
 
+// RetainedStateRoots returns the state roots which state pruning keeps: the
+// state at the start height of every round, which the governance reads the
+// round configurations from, and the states of the given number of most
+// recent blocks.
+func (bc *BlockChain) RetainedStateRoots(recent uint64) ([]common.Hash, error) {
+	return bc.retainedStateRoots(bc.CurrentBlock(), recent)
+}
+
+func (bc *BlockChain) retainedStateRoots(head *types.Block, recent uint64) ([]common.Hash, error) {
+	statedb, err := state.New(head.Root(), bc.stateCache)
+	if err != nil {
+		return nil, err
+	}
+	gs := &vm.GovernanceState{StateDB: statedb}
+
+	var (
+		roots []common.Hash
+		seen  = make(map[common.Hash]struct{})
+	)
+	addRoot := func(root common.Hash) {
+		if _, ok := seen[root]; !ok {
+			seen[root] = struct{}{}
+			roots = append(roots, root)
+		}
+	}
+	addRoot(head.Root())
+
+	for round := uint64(0); round <= head.Round(); round++ {
+		height, ok := bc.GetRoundHeight(round)
+		if !ok {
+			height = gs.RoundHeight(new(big.Int).SetUint64(round)).Uint64()
+			if round != 0 && height == 0 {
+				continue
+			}
+		}
+		if header := bc.GetHeaderByNumber(height); header != nil {
+			addRoot(header.Root)
+		}
+	}
+	number := head.NumberU64()
+	for i := uint64(1); i < recent && i <= number; i++ {
+		if header := bc.GetHeaderByNumber(number - i); header != nil {
+			addRoot(header.Root)
+		}
+	}
+	return roots, nil
+}
+
+// pruneState starts pruning the historical state in background when a new
+// round begins. The state of the round start block was just written to disk.
+// Besides the retained states, the recent states still held in memory are
+// marked too, so that every state derived afterwards refers to either entries
+// marked live or entries written during the pruning, which the pruner tracks.
+func (bc *BlockChain) pruneState(block *types.Block) {
+	roots, err := bc.retainedStateRoots(block, bc.cacheConfig.StatePruneBlocks)
+	if err != nil {
+		log.Error("Failed to collect retained state roots", "err", err)
+		return
+	}
+	if err := bc.pruner.Start(); err != nil {
+		log.Warn("Skipping state pruning", "round", block.Round(), "err", err)
+		return
+	}
+	memoryRoots := bc.memoryStateRoots()
+	triedb := bc.stateCache.TrieDB()
+
+	bc.wg.Add(1)
+	go func() {
+		defer bc.wg.Done()
+
+		start := time.Now()
+		if err := bc.pruner.Prune(triedb, roots, memoryRoots, bc.quit); err != nil {
+			log.Error("Failed to prune state", "round", block.Round(), "err", err)
+			return
+		}
+		log.Info("Pruned historical state", "round", block.Round(),
+			"elapsed", common.PrettyDuration(time.Since(start)))
+	}()
+}
+
+// memoryStateRoots returns the roots of the states referenced in memory by the
+// garbage collector, leaving the collector untouched.
+func (bc *BlockChain) memoryStateRoots() []common.Hash {
+	var (
+		roots      []common.Hash
+		priorities []int64
+	)
+	for !bc.triegc.Empty() {
+		root, priority := bc.triegc.Pop()
+		roots = append(roots, root.(common.Hash))
+		priorities = append(priorities, priority)
+	}
+	for i, root := range roots {
+		bc.triegc.Push(root, priorities[i])
+	}
+	return roots
+}
+
+// GetGovernanceSnapshot returns the governance snapshot taken at the start of
+// the given round.
+func (bc *BlockChain) GetGovernanceSnapshot(round uint64) *types.GovernanceSnapshot {
+	return bc.gov.GetSnapshotAtRound(round)
+}
+
 // GetRoundHeight returns the height of a given round.
 func (bc *BlockChain) GetRoundHeight(round uint64) (uint64, bool) {
 	h, ok := bc.roundHeightMap.Load(round)
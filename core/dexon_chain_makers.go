@@ -107,6 +107,7 @@ func (b *DexonBlockGen) ProcessTransactions(c ChainContext) {
 	if b.gasPool == nil {
 		b.SetCoinbase(common.Address{})
 	}
+	vm.ActivateOracleContracts(b.config, b.header.Number, b.statedb)
 	for _, tx := range b.txs {
 		b.statedb.Prepare(tx.Hash(), common.Hash{}, len(b.txs))
 		// TODO: fix the chain context parameter
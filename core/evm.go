@@ -17,6 +17,8 @@
 package core
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/dexon-foundation/dexon/common"
@@ -24,8 +26,13 @@ import (
 	"github.com/dexon-foundation/dexon/core/state"
 	"github.com/dexon-foundation/dexon/core/types"
 	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/params"
 )
 
+// errNoChainContext is returned when chain data is requested from an EVM
+// context created without a chain.
+var errNoChainContext = errors.New("no chain context")
+
 // ChainContext supports retrieving headers and consensus parameters from the
 // current blockchain to be used during transaction processing.
 type ChainContext interface {
@@ -59,8 +66,10 @@ func NewEVMContext(msg Message, header *types.Header, chain ChainContext, author
 		CanTransfer:    CanTransfer,
 		Transfer:       Transfer,
 		GetHash:        GetHashFn(header, chain),
+		GetRandomness:  GetRandomnessFn(header, chain),
 		StateAtNumber:  StateAtNumberFn(chain),
 		GetRoundHeight: GetRoundHeightFn(chain),
+		GetRoundConfig: GetRoundConfigFn(chain),
 		Origin:         msg.From(),
 		Coinbase:       beneficiary,
 		BlockNumber:    new(big.Int).Set(header.Number),
@@ -74,8 +83,11 @@ func NewEVMContext(msg Message, header *types.Header, chain ChainContext, author
 }
 
 // StateAtNumberFn returns a StateAtNumberFunc which allows the retrieval of
-// statedb at a given block height.
+// statedb at a given block height, or nil if there is no chain.
 func StateAtNumberFn(chain ChainContext) func(n uint64) (*state.StateDB, error) {
+	if chain == nil {
+		return nil
+	}
 	return func(n uint64) (*state.StateDB, error) {
 		header := chain.GetHeaderByNumber(n)
 		return chain.StateAt(header.Root)
@@ -91,6 +103,21 @@ func GetRoundHeightFn(chain ChainContext) func(uint64) (uint64, bool) {
 	}
 }
 
+// GetRoundConfigFn returns a GetRoundConfigFunc which retrieves the governance
+// configuration of a round from the governance snapshots of the chain, or nil
+// if the chain does not keep governance snapshots.
+func GetRoundConfigFn(chain ChainContext) func(uint64) *params.DexconConfig {
+	c, ok := chain.(interface {
+		GetGovernanceSnapshot(round uint64) *types.GovernanceSnapshot
+	})
+	if !ok {
+		return nil
+	}
+	return func(round uint64) *params.DexconConfig {
+		return c.GetGovernanceSnapshot(round).Config
+	}
+}
+
 // GetHashFn returns a GetHashFunc which retrieves header hashes by number
 func GetHashFn(ref *types.Header, chain ChainContext) func(n uint64) common.Hash {
 	var cache map[uint64]common.Hash
@@ -117,6 +144,36 @@ func GetHashFn(ref *types.Header, chain ChainContext) func(n uint64) common.Hash
 	}
 }
 
+// GetRandomnessFn returns a GetRandomnessFunc which retrieves the randomness
+// of ancestor headers by number
+func GetRandomnessFn(ref *types.Header, chain ChainContext) func(n uint64) ([]byte, error) {
+	var cache map[uint64][]byte
+
+	return func(n uint64) ([]byte, error) {
+		if chain == nil {
+			return nil, errNoChainContext
+		}
+		if cache == nil {
+			cache = make(map[uint64][]byte)
+		}
+		// Try to fulfill the request from the cache
+		if randomness, ok := cache[n]; ok {
+			return randomness, nil
+		}
+		// Not cached, iterate the blocks and cache the randomness
+		for header := chain.GetHeader(ref.ParentHash, ref.Number.Uint64()-1); header != nil; header = chain.GetHeader(header.ParentHash, header.Number.Uint64()-1) {
+			cache[header.Number.Uint64()] = header.Randomness
+			if n == header.Number.Uint64() {
+				return header.Randomness, nil
+			}
+			if header.Number.Uint64() == 0 {
+				break
+			}
+		}
+		return nil, fmt.Errorf("randomness of block %d not found", n)
+	}
+}
+
 // CanTransfer checks whether there are enough funds in the address' account to make a transfer.
 // This does not take the necessary gas in to account to make the transfer valid.
 func CanTransfer(db vm.StateDB, addr common.Address, amount *big.Int) bool {
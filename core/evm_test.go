@@ -0,0 +1,65 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core/types"
+)
+
+// headerChain is a ChainContext serving headers only.
+type headerChain struct {
+	ChainContext
+	headers map[common.Hash]*types.Header
+}
+
+func (c *headerChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	return c.headers[hash]
+}
+
+func TestGetRandomnessFn(t *testing.T) {
+	// Without a chain the randomness is not available, but doesn't panic.
+	getRandomness := GetRandomnessFn(&types.Header{Number: big.NewInt(1)}, nil)
+	if _, err := getRandomness(0); err != errNoChainContext {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoChainContext)
+	}
+
+	chain := &headerChain{headers: make(map[common.Hash]*types.Header)}
+	var parent common.Hash
+	for i := int64(0); i < 3; i++ {
+		header := &types.Header{Number: big.NewInt(i), ParentHash: parent, Randomness: []byte{byte(i + 1)}}
+		chain.headers[header.Hash()] = header
+		parent = header.Hash()
+	}
+	getRandomness = GetRandomnessFn(&types.Header{Number: big.NewInt(3), ParentHash: parent}, chain)
+	for i := uint64(0); i < 3; i++ {
+		randomness, err := getRandomness(i)
+		if err != nil {
+			t.Fatalf("block %d: failed to get randomness: %v", i, err)
+		}
+		if !bytes.Equal(randomness, []byte{byte(i + 1)}) {
+			t.Errorf("block %d: randomness mismatch: have %x", i, randomness)
+		}
+	}
+	if _, err := getRandomness(3); err == nil {
+		t.Errorf("got randomness of the current block")
+	}
+}
@@ -18,6 +18,7 @@ package core
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -26,6 +27,9 @@ import (
 	"sort"
 	"strings"
 
+	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
+	coreUtils "github.com/dexon-foundation/dexon-consensus/core/utils"
+
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/common/hexutil"
 	"github.com/dexon-foundation/dexon/common/math"
@@ -33,6 +37,7 @@ import (
 	"github.com/dexon-foundation/dexon/core/state"
 	"github.com/dexon-foundation/dexon/core/types"
 	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
 	"github.com/dexon-foundation/dexon/ethdb"
 	"github.com/dexon-foundation/dexon/log"
 	"github.com/dexon-foundation/dexon/params"
@@ -255,6 +260,105 @@ func (a AllocKey) Swap(i int, j int) {
 	a[i], a[j] = a[j], a[i]
 }
 
+// Validate checks the DEXON specific invariants of the genesis, which would
+// otherwise only surface as a stalled network after the genesis is committed.
+// Genesis specifications without a Dexcon configuration are always valid.
+func (g *Genesis) Validate() error {
+	if g.Config == nil || g.Config.Dexcon == nil {
+		return nil
+	}
+	config := g.Config.Dexcon
+	switch {
+	case config.MinStake == nil || config.MinStake.Sign() <= 0:
+		return errors.New("dexcon: minimum stake must be positive")
+	case config.NextHalvingSupply == nil || config.LastHalvedAmount == nil:
+		return errors.New("dexcon: missing halving supply")
+	case config.MinGasPrice == nil:
+		return errors.New("dexcon: missing minimum gas price")
+	case config.BlockGasLimit == 0:
+		return errors.New("dexcon: zero block gas limit")
+	case config.LambdaBA == 0 || config.LambdaDKG == 0:
+		return errors.New("dexcon: zero lambda")
+	case config.RoundLength == 0:
+		return errors.New("dexcon: zero round length")
+	case len(config.FineValues) != vm.FineTypeForkBlock+1:
+		return fmt.Errorf("dexcon: fine values length mismatch: have %d, want %d",
+			len(config.FineValues), vm.FineTypeForkBlock+1)
+	case g.Config.Recovery == nil:
+		return errors.New("dexcon: missing recovery configuration")
+	case g.Config.Recovery.Timeout == 0:
+		return errors.New("dexcon: zero recovery timeout")
+	}
+	for i, value := range config.FineValues {
+		if value == nil || value.Sign() < 0 {
+			return fmt.Errorf("dexcon: invalid fine value %d", i)
+		}
+	}
+
+	// Check the allocations in the same order the nodes are registered.
+	keys := AllocKey{}
+	for addr := range g.Alloc {
+		keys = append(keys, addr)
+	}
+	sort.Sort(keys)
+
+	totalSupply := big.NewInt(0)
+	nodeKeys := make(map[common.Address]common.Address)
+	for _, addr := range keys {
+		account := g.Alloc[addr]
+		switch {
+		case account.Balance == nil:
+			return fmt.Errorf("account %x: missing balance", addr)
+		case account.Staked == nil:
+			return fmt.Errorf("account %x: missing staked amount", addr)
+		case account.Staked.Cmp(account.Balance) > 0:
+			return fmt.Errorf("account %x: staked %v exceeds balance %v", addr, account.Staked, account.Balance)
+		}
+		totalSupply.Add(totalSupply, account.Balance)
+		if account.Staked.Sign() == 0 {
+			continue
+		}
+		if account.Staked.Cmp(config.MinStake) < 0 {
+			return fmt.Errorf("account %x: staked %v below minimum stake %v", addr, account.Staked, config.MinStake)
+		}
+		pk, err := crypto.UnmarshalPubkey(account.PublicKey)
+		if err != nil {
+			return fmt.Errorf("account %x: invalid node public key: %v", addr, err)
+		}
+		// The register method of the governance contract limits the node
+		// info, but genesis allocations were never held to it, so only warn.
+		info := account.NodeInfo
+		if len(info.Name) >= 32 || len(info.Email) >= 32 || len(info.Location) >= 32 || len(info.Url) >= 128 {
+			log.Warn("Genesis node info exceeds register limits", "account", addr,
+				"name", len(info.Name), "email", len(info.Email), "location", len(info.Location), "url", len(info.Url))
+		}
+		nodeKey := crypto.PubkeyToAddress(*pk)
+		if other, ok := nodeKeys[nodeKey]; ok {
+			return fmt.Errorf("account %x: node key already registered by %x", addr, other)
+		}
+		nodeKeys[nodeKey] = addr
+	}
+	if totalSupply.Cmp(config.NextHalvingSupply) >= 0 {
+		return fmt.Errorf("dexcon: total supply %v reaches next halving supply %v", totalSupply, config.NextHalvingSupply)
+	}
+
+	// Rounds 0 and 1 are both configured by the genesis state, whose notary
+	// set has to be able to reach agreement.
+	db := ethdb.NewMemDatabase()
+	statedb, err := state.New(g.ToBlock(db).Root(), state.NewDatabase(db))
+	if err != nil {
+		return err
+	}
+	gov := &vm.GovernanceState{StateDB: statedb}
+	threshold := coreUtils.GetBAThreshold(&coreTypes.Config{
+		NotarySetSize: uint32(gov.NotarySetSize().Uint64())})
+	if qualified := len(gov.QualifiedNodes()); qualified < threshold {
+		return fmt.Errorf("dexcon: %d qualified nodes cannot reach the agreement threshold %d of notary set size %d",
+			qualified, threshold, gov.NotarySetSize())
+	}
+	return nil
+}
+
 // ToBlock creates the genesis block and writes state of a genesis specification
 // to the given database (or discards it if nil).
 func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
@@ -312,9 +416,7 @@ func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
 	}
 
 	// Set oracle contract.
-	for address := range vm.OracleContracts {
-		statedb.SetCode(address, []byte{0xed})
-	}
+	vm.InitOracleContracts(g.Config, statedb)
 
 	root := statedb.IntermediateRoot(false)
 	head := &types.Header{
@@ -459,6 +561,74 @@ func DeveloperGenesisBlock(period uint64, faucet common.Address) *Genesis {
 	}
 }
 
+// DexconDeveloperGenesisBlock returns the 'gdex --dev' genesis block. The
+// developer account is pre-funded and owns the governance, the given nodes are
+// pre-funded and registered as the notaries of the network. The period is the
+// minimum block interval in milliseconds.
+func DexconDeveloperGenesisBlock(period uint64, dMoment uint64, developer *ecdsa.PublicKey, nodes ...*ecdsa.PublicKey) *Genesis {
+	// The DKG phases are counted in blocks, each must last at least one.
+	lambdaDKG := uint64(1000)
+	if period > lambdaDKG {
+		lambdaDKG = period
+	}
+	config := *params.AllDexconProtocolChanges
+	config.DMoment = dMoment
+	config.Dexcon = &params.DexconConfig{
+		GenesisCRSText:    "In DEXON, we trust.",
+		Owner:             crypto.PubkeyToAddress(*developer),
+		MinStake:          new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6)),
+		LockupPeriod:      86400 * 1000,
+		MiningVelocity:    0.1875,
+		NextHalvingSupply: new(big.Int).Mul(big.NewInt(1e18), big.NewInt(2.5e9)),
+		LastHalvedAmount:  new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1.5e9)),
+		MinGasPrice:       big.NewInt(params.GWei),
+		BlockGasLimit:     40000000,
+		LambdaBA:          250,
+		LambdaDKG:         lambdaDKG,
+		NotaryParamAlpha:  70.5,
+		NotaryParamBeta:   264,
+		RoundLength:       100,
+		MinBlockInterval:  period,
+		FineValues: []*big.Int{
+			new(big.Int).Mul(big.NewInt(1e18), big.NewInt(200)),
+			new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1)),
+			new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6)),
+			new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6)),
+			new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6)),
+		},
+	}
+	config.Recovery = &params.RecoveryConfig{
+		Timeout:      30,
+		Confirmation: 1,
+	}
+
+	// Assemble and return the genesis with the nodes pre-funded and staked.
+	alloc := GenesisAlloc{}
+	for i, key := range nodes {
+		alloc[crypto.PubkeyToAddress(*key)] = GenesisAccount{
+			Balance:   new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e7)),
+			Staked:    config.Dexcon.MinStake,
+			PublicKey: crypto.FromECDSAPub(key),
+			NodeInfo:  NodeInfo{Name: fmt.Sprintf("Node %d", i+1)},
+		}
+	}
+	// The developer account may be one of the nodes as well.
+	account := alloc[crypto.PubkeyToAddress(*developer)]
+	account.Balance = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e9))
+	if account.Staked == nil {
+		account.Staked = big.NewInt(0)
+	}
+	alloc[crypto.PubkeyToAddress(*developer)] = account
+	return &Genesis{
+		Config:     &config,
+		Timestamp:  dMoment * 1000,
+		Nonce:      0x42,
+		GasLimit:   config.Dexcon.BlockGasLimit,
+		Difficulty: big.NewInt(1),
+		Alloc:      alloc,
+	}
+}
+
 func decodePrealloc(data string) GenesisAlloc {
 	type accountData struct {
 		Balance   *big.Int
@@ -17,15 +17,19 @@
 package core
 
 import (
+	"crypto/ecdsa"
 	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/consensus/ethash"
 	"github.com/dexon-foundation/dexon/core/rawdb"
+	"github.com/dexon-foundation/dexon/core/state"
 	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
 	"github.com/dexon-foundation/dexon/ethdb"
 	"github.com/dexon-foundation/dexon/params"
 )
@@ -182,3 +186,133 @@ func TestSetupGenesis(t *testing.T) {
 		}
 	}
 }
+
+func TestDexconDeveloperGenesisBlock(t *testing.T) {
+	developer, _ := crypto.GenerateKey()
+	key, _ := crypto.GenerateKey()
+	owner := crypto.PubkeyToAddress(developer.PublicKey)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	db := ethdb.NewMemDatabase()
+	genesis := DexconDeveloperGenesisBlock(500, 1500000000, &developer.PublicKey, &key.PublicKey)
+	block := genesis.MustCommit(db)
+	if block.Time() != 1500000000*1000 {
+		t.Errorf("genesis time mismatch: have %d, want %d", block.Time(), 1500000000*1000)
+	}
+
+	statedb, err := state.New(block.Root(), state.NewDatabase(db))
+	if err != nil {
+		t.Fatalf("failed to open genesis state: %v", err)
+	}
+	funds := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e9))
+	if balance := statedb.GetBalance(owner); balance.Cmp(funds) != 0 {
+		t.Errorf("developer balance mismatch: have %v, want %v", balance, funds)
+	}
+	gs := vm.GovernanceState{StateDB: statedb}
+	if n := gs.LenNodes().Uint64(); n != 1 {
+		t.Fatalf("node count mismatch: have %d, want 1", n)
+	}
+	node := gs.Node(big.NewInt(0))
+	if node.Owner != addr {
+		t.Errorf("node owner mismatch: have %x, want %x", node.Owner, addr)
+	}
+	if node.Staked.Cmp(genesis.Config.Dexcon.MinStake) < 0 {
+		t.Errorf("node stake %v below minimum %v", node.Staked, genesis.Config.Dexcon.MinStake)
+	}
+	if o := gs.Owner(); o != owner {
+		t.Errorf("governance owner mismatch: have %x, want %x", o, owner)
+	}
+	if interval := gs.MinBlockInterval().Uint64(); interval != 500 {
+		t.Errorf("block interval mismatch: have %d, want 500", interval)
+	}
+}
+
+func TestGenesisValidate(t *testing.T) {
+	for name, genesis := range map[string]*Genesis{
+		"mainnet": DefaultGenesisBlock(),
+		"testnet": DefaultTestnetGenesisBlock(),
+		"taipei":  DefaultTaipeiGenesisBlock(),
+		"yilan":   DefaultYilanGenesisBlock(),
+	} {
+		if err := genesis.Validate(); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+	}
+
+	var keys []*ecdsa.PublicKey
+	for i := 0; i < 4; i++ {
+		key, _ := crypto.GenerateKey()
+		keys = append(keys, &key.PublicKey)
+	}
+	node := crypto.PubkeyToAddress(*keys[1])
+
+	tests := []struct {
+		name   string
+		keys   int
+		modify func(*Genesis)
+		valid  bool
+	}{
+		{name: "valid", keys: 4, modify: func(g *Genesis) {}, valid: true},
+		{name: "single node", keys: 1, modify: func(g *Genesis) {}, valid: true},
+		{name: "too few qualified nodes", keys: 2, modify: func(g *Genesis) {}},
+		{name: "zero round length", keys: 4, modify: func(g *Genesis) {
+			g.Config.Dexcon.RoundLength = 0
+		}},
+		{name: "missing fine value", keys: 4, modify: func(g *Genesis) {
+			g.Config.Dexcon.FineValues = g.Config.Dexcon.FineValues[1:]
+		}},
+		{name: "missing recovery", keys: 4, modify: func(g *Genesis) {
+			g.Config.Recovery = nil
+		}},
+		{name: "missing staked", keys: 4, modify: func(g *Genesis) {
+			account := g.Alloc[node]
+			account.Staked = nil
+			g.Alloc[node] = account
+		}},
+		{name: "stake below minimum", keys: 4, modify: func(g *Genesis) {
+			g.Config.Dexcon.MinStake = new(big.Int).Add(g.Alloc[node].Staked, big.NewInt(1))
+		}},
+		{name: "invalid public key", keys: 4, modify: func(g *Genesis) {
+			account := g.Alloc[node]
+			account.PublicKey = account.PublicKey[1:]
+			g.Alloc[node] = account
+		}},
+		{name: "duplicate node key", keys: 4, modify: func(g *Genesis) {
+			account := g.Alloc[node]
+			account.PublicKey = crypto.FromECDSAPub(keys[0])
+			g.Alloc[node] = account
+		}},
+		{name: "node info over register limits", keys: 4, modify: setNodeInfo(node, func(info *NodeInfo) {
+			info.Name = strings.Repeat("n", 32)
+			info.Email = strings.Repeat("e", 32)
+			info.Location = strings.Repeat("l", 32)
+			info.Url = strings.Repeat("u", 128)
+		}), valid: true},
+		{name: "total supply too large", keys: 4, modify: func(g *Genesis) {
+			account := g.Alloc[node]
+			account.Balance = g.Config.Dexcon.NextHalvingSupply
+			g.Alloc[node] = account
+		}},
+	}
+	for _, test := range tests {
+		genesis := DexconDeveloperGenesisBlock(500, 1500000000, keys[0], keys[:test.keys]...)
+		test.modify(genesis)
+		err := genesis.Validate()
+		if test.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
+
+// setNodeInfo returns a genesis modifier updating the node info of the given
+// account.
+func setNodeInfo(addr common.Address, update func(*NodeInfo)) func(*Genesis) {
+	return func(g *Genesis) {
+		account := g.Alloc[addr]
+		update(&account.NodeInfo)
+		g.Alloc[addr] = account
+	}
+}
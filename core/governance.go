@@ -17,17 +17,28 @@ import (
 	"github.com/hashicorp/golang-lru/simplelru"
 
 	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core/rawdb"
 	"github.com/dexon-foundation/dexon/core/state"
+	"github.com/dexon-foundation/dexon/core/types"
 	"github.com/dexon-foundation/dexon/core/vm"
 	"github.com/dexon-foundation/dexon/crypto"
 	"github.com/dexon-foundation/dexon/log"
+	"github.com/dexon-foundation/dexon/rlp"
 )
 
-const dkgCacheSize = 5
+const (
+	dkgCacheSize      = 5
+	snapshotCacheSize = 5
+)
 
 type GovernanceStateDB interface {
 	State() (*state.StateDB, error)
 	StateAt(height uint64) (*state.StateDB, error)
+
+	// ReadSnapshot returns the persisted governance snapshot of a round, or
+	// nil if it is not stored.
+	ReadSnapshot(round uint64) *types.GovernanceSnapshot
+	WriteSnapshot(snapshot *types.GovernanceSnapshot)
 }
 
 func NewGovernanceStateDB(bc *BlockChain) GovernanceStateDB {
@@ -50,6 +61,14 @@ func (g *governanceStateDB) StateAt(height uint64) (*state.StateDB, error) {
 	return g.bc.StateAt(header.Root)
 }
 
+func (g *governanceStateDB) ReadSnapshot(round uint64) *types.GovernanceSnapshot {
+	return rawdb.ReadGovernanceSnapshot(g.bc.db, round)
+}
+
+func (g *governanceStateDB) WriteSnapshot(snapshot *types.GovernanceSnapshot) {
+	rawdb.WriteGovernanceSnapshot(g.bc.db, snapshot)
+}
+
 type dkgCacheItem struct {
 	Round               uint64
 	Reset               uint64
@@ -60,10 +79,12 @@ type dkgCacheItem struct {
 }
 
 type Governance struct {
-	db           GovernanceStateDB
-	nodeSetCache *dexCore.NodeSetCache
-	dkgCache     *simplelru.LRU
-	dkgCacheMu   sync.RWMutex
+	db              GovernanceStateDB
+	nodeSetCache    *dexCore.NodeSetCache
+	dkgCache        *simplelru.LRU
+	dkgCacheMu      sync.RWMutex
+	snapshotCache   *simplelru.LRU
+	snapshotCacheMu sync.Mutex
 }
 
 func NewGovernance(db GovernanceStateDB) *Governance {
@@ -72,9 +93,15 @@ func NewGovernance(db GovernanceStateDB) *Governance {
 		log.Error("Failed to initialize DKG cache", "error", err)
 		return nil
 	}
+	snapshotCache, err := simplelru.NewLRU(snapshotCacheSize, nil)
+	if err != nil {
+		log.Error("Failed to initialize governance snapshot cache", "error", err)
+		return nil
+	}
 	g := &Governance{
-		db:       db,
-		dkgCache: cache,
+		db:            db,
+		dkgCache:      cache,
+		snapshotCache: snapshotCache,
 	}
 	g.nodeSetCache = dexCore.NewNodeSetCache(g)
 	return g
@@ -106,13 +133,38 @@ func (g *Governance) getHelperAtRound(round uint64) *vm.GovernanceState {
 	return &vm.GovernanceState{StateDB: s}
 }
 
-func (g *Governance) GetStateForConfigAtRound(round uint64) *vm.GovernanceState {
+func configRound(round uint64) uint64 {
 	if round < dexCore.ConfigRoundShift {
-		round = 0
-	} else {
-		round -= dexCore.ConfigRoundShift
+		return 0
 	}
-	return g.getHelperAtRound(round)
+	return round - dexCore.ConfigRoundShift
+}
+
+func (g *Governance) GetStateForConfigAtRound(round uint64) *vm.GovernanceState {
+	return g.getHelperAtRound(configRound(round))
+}
+
+// GetSnapshotAtRound returns the governance snapshot taken at the start of
+// the given round. The snapshot is read from the database and is only derived
+// from the historical state, then persisted, if it is not stored yet.
+func (g *Governance) GetSnapshotAtRound(round uint64) *types.GovernanceSnapshot {
+	g.snapshotCacheMu.Lock()
+	defer g.snapshotCacheMu.Unlock()
+
+	if v, ok := g.snapshotCache.Get(round); ok {
+		return v.(*types.GovernanceSnapshot)
+	}
+	snapshot := g.db.ReadSnapshot(round)
+	if snapshot == nil {
+		snapshot = g.getHelperAtRound(round).Snapshot(round, g.GetRoundHeight(round))
+		g.db.WriteSnapshot(snapshot)
+	}
+	g.snapshotCache.Add(round, snapshot)
+	return snapshot
+}
+
+func (g *Governance) GetSnapshotForConfigAtRound(round uint64) *types.GovernanceSnapshot {
+	return g.GetSnapshotAtRound(configRound(round))
 }
 
 func (g *Governance) GetStateAtRound(round uint64) *vm.GovernanceState {
@@ -136,6 +188,16 @@ func (g *Governance) GetStateForDKGAtRound(round uint64) *vm.GovernanceState {
 	return g.GetStateAtRound(round)
 }
 
+// dkgSnapshotAtRound returns the governance snapshot of a round whose DKG has
+// been superseded by a later round, or nil if the DKG of the round is ongoing
+// or not started yet.
+func (g *Governance) dkgSnapshotAtRound(round uint64) *types.GovernanceSnapshot {
+	if round >= g.GetHeadState().DKGRound().Uint64() {
+		return nil
+	}
+	return g.GetSnapshotAtRound(round)
+}
+
 func (g *Governance) CRSRound() uint64 {
 	return g.GetHeadState().CRSRound().Uint64()
 }
@@ -143,8 +205,7 @@ func (g *Governance) CRSRound() uint64 {
 // CRS returns the CRS for a given round.
 func (g *Governance) CRS(round uint64) coreCommon.Hash {
 	if round <= dexCore.DKGDelayRound {
-		s := g.GetStateAtRound(0)
-		crs := s.CRS()
+		crs := g.GetSnapshotAtRound(0).CRS
 		for i := uint64(0); i < round; i++ {
 			crs = crypto.Keccak256Hash(crs[:])
 		}
@@ -153,18 +214,14 @@ func (g *Governance) CRS(round uint64) coreCommon.Hash {
 	if round > g.CRSRound() {
 		return coreCommon.Hash{}
 	}
-	var s *vm.GovernanceState
 	if round == g.CRSRound() {
-		s = g.GetHeadState()
-	} else {
-		s = g.GetStateAtRound(round)
+		return coreCommon.Hash(g.GetHeadState().CRS())
 	}
-	return coreCommon.Hash(s.CRS())
+	return coreCommon.Hash(g.GetSnapshotAtRound(round).CRS)
 }
 
 func (g *Governance) Configuration(round uint64) *coreTypes.Config {
-	configHelper := g.GetStateForConfigAtRound(round)
-	c := configHelper.Configuration()
+	c := g.GetSnapshotForConfigAtRound(round).Config
 	return &coreTypes.Config{
 		LambdaBA:         time.Duration(c.LambdaBA) * time.Millisecond,
 		LambdaDKG:        time.Duration(c.LambdaDKG) * time.Millisecond,
@@ -180,11 +237,11 @@ func (g *Governance) GetRoundHeight(round uint64) uint64 {
 
 // NodeSet returns the current node set.
 func (g *Governance) NodeSet(round uint64) []coreCrypto.PublicKey {
-	s := g.GetStateForConfigAtRound(round)
+	s := g.GetSnapshotForConfigAtRound(round)
 	var pks []coreCrypto.PublicKey
 
-	for _, n := range s.QualifiedNodes() {
-		pk, err := coreEcdsa.NewPublicKeyFromByteSlice(n.PublicKey)
+	for _, key := range s.NodePublicKeys {
+		pk, err := coreEcdsa.NewPublicKeyFromByteSlice(key)
 		if err != nil {
 			panic(err)
 		}
@@ -232,7 +289,48 @@ func (g *Governance) DKGSetNodeKeyAddresses(round uint64) (map[common.Address]st
 	return r, nil
 }
 
+func (g *Governance) getDKGCacheFromSnapshot(snapshot *types.GovernanceSnapshot) *dkgCacheItem {
+	g.dkgCacheMu.Lock()
+	defer g.dkgCacheMu.Unlock()
+
+	if v, ok := g.dkgCache.Get(snapshot.Round); ok {
+		cache := v.(*dkgCacheItem)
+		if cache.Reset == snapshot.DKGResetCount &&
+			cache.MasterPublicKeysLen == uint64(len(snapshot.DKGMasterPublicKeys)) &&
+			cache.ComplaintsLen == uint64(len(snapshot.DKGComplaints)) {
+			return cache
+		}
+	}
+
+	cache := &dkgCacheItem{
+		Round:               snapshot.Round,
+		Reset:               snapshot.DKGResetCount,
+		MasterPublicKeysLen: uint64(len(snapshot.DKGMasterPublicKeys)),
+		ComplaintsLen:       uint64(len(snapshot.DKGComplaints)),
+	}
+	for _, data := range snapshot.DKGMasterPublicKeys {
+		mpk := new(dkgTypes.MasterPublicKey)
+		if err := rlp.DecodeBytes(data, mpk); err != nil {
+			panic(err)
+		}
+		cache.MasterPublicKeys = append(cache.MasterPublicKeys, mpk)
+	}
+	for _, data := range snapshot.DKGComplaints {
+		complaint := new(dkgTypes.Complaint)
+		if err := rlp.DecodeBytes(data, complaint); err != nil {
+			panic(err)
+		}
+		cache.Complaints = append(cache.Complaints, complaint)
+	}
+
+	g.dkgCache.Add(snapshot.Round, cache)
+	return cache
+}
+
 func (g *Governance) getOrUpdateDKGCache(round uint64) *dkgCacheItem {
+	if snapshot := g.dkgSnapshotAtRound(round); snapshot != nil {
+		return g.getDKGCacheFromSnapshot(snapshot)
+	}
 	s := g.GetStateForDKGAtRound(round)
 	if s == nil {
 		log.Error("Failed to get DKG state", "round", round)
@@ -289,38 +387,47 @@ func (g *Governance) DKGMasterPublicKeys(round uint64) []*dkgTypes.MasterPublicK
 }
 
 func (g *Governance) IsDKGMPKReady(round uint64) bool {
-	s := g.GetStateForDKGAtRound(round)
-	if s == nil {
+	var count uint64
+	if snapshot := g.dkgSnapshotAtRound(round); snapshot != nil {
+		count = snapshot.DKGMPKReadysCount
+	} else if s := g.GetStateForDKGAtRound(round); s != nil {
+		count = s.DKGMPKReadysCount().Uint64()
+	} else {
 		return false
 	}
 	config := g.Configuration(round)
 	threshold := 2*uint64(config.NotarySetSize)/3 + 1
-	count := s.DKGMPKReadysCount().Uint64()
 	return count >= threshold
 }
 
 func (g *Governance) IsDKGFinal(round uint64) bool {
-	s := g.GetStateForDKGAtRound(round)
-	if s == nil {
+	var count uint64
+	if snapshot := g.dkgSnapshotAtRound(round); snapshot != nil {
+		count = snapshot.DKGFinalizedsCount
+	} else if s := g.GetStateForDKGAtRound(round); s != nil {
+		count = s.DKGFinalizedsCount().Uint64()
+	} else {
 		return false
 	}
 	config := g.Configuration(round)
 	threshold := 2*uint64(config.NotarySetSize)/3 + 1
-	count := s.DKGFinalizedsCount().Uint64()
 	return count >= threshold
 }
 
 func (g *Governance) IsDKGSuccess(round uint64) bool {
-	s := g.GetStateForDKGAtRound(round)
-	if s == nil {
+	var count uint64
+	if snapshot := g.dkgSnapshotAtRound(round); snapshot != nil {
+		count = snapshot.DKGSuccessesCount
+	} else if s := g.GetStateForDKGAtRound(round); s != nil {
+		count = s.DKGSuccessesCount().Uint64()
+	} else {
 		return false
 	}
-	return s.DKGSuccessesCount().Uint64() >=
-		uint64(coreUtils.GetDKGValidThreshold(g.Configuration(round)))
+	return count >= uint64(coreUtils.GetDKGValidThreshold(g.Configuration(round)))
 }
 
 func (g *Governance) MinGasPrice(round uint64) *big.Int {
-	return g.GetStateForConfigAtRound(round).MinGasPrice()
+	return new(big.Int).Set(g.GetSnapshotForConfigAtRound(round).Config.MinGasPrice)
 }
 
 func (g *Governance) DKGResetCount(round uint64) uint64 {
@@ -6,6 +6,7 @@ import (
 	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
 
 	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/ethdb"
 	"github.com/dexon-foundation/dexon/log"
 	"github.com/dexon-foundation/dexon/rlp"
 )
@@ -49,3 +50,55 @@ func WriteCoreBlock(db DatabaseWriter, hash common.Hash, block *coreTypes.Block)
 	}
 	WriteCoreBlockRLP(db, hash, data)
 }
+
+func DeleteCoreBlock(db DatabaseDeleter, hash common.Hash) {
+	if err := db.Delete(coreBlockKey(hash)); err != nil {
+		log.Crit("Failed to delete core block", "err", err)
+	}
+}
+
+// CoreBlockIterator iterates over all core blocks stored in the database, in
+// the order of their hashes.
+type CoreBlockIterator struct {
+	it  ethdb.Iterator
+	err error
+}
+
+// NewCoreBlockIterator creates an iterator over all core blocks in db. The
+// iterator must be released after use.
+func NewCoreBlockIterator(db ethdb.Iteratee) *CoreBlockIterator {
+	return &CoreBlockIterator{it: db.NewIteratorWithPrefix(coreBlockPrefix)}
+}
+
+// Next returns the next core block, or nil if the iteration is finished or
+// failed, which can be told apart by Error.
+func (it *CoreBlockIterator) Next() *coreTypes.Block {
+	if it.err != nil {
+		return nil
+	}
+	for it.it.Next() {
+		// coreBlockPrefix is shared by other keys (e.g. the DKG private keys),
+		// only keys of the exact core block key length are core blocks.
+		if len(it.it.Key()) != len(coreBlockPrefix)+common.HashLength {
+			continue
+		}
+		block := new(coreTypes.Block)
+		if err := rlp.DecodeBytes(it.it.Value(), block); err != nil {
+			it.err = err
+			return nil
+		}
+		return block
+	}
+	it.err = it.it.Error()
+	return nil
+}
+
+// Error returns any error encountered during the iteration.
+func (it *CoreBlockIterator) Error() error {
+	return it.err
+}
+
+// Release releases the underlying database iterator.
+func (it *CoreBlockIterator) Release() {
+	it.it.Release()
+}
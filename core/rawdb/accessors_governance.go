@@ -0,0 +1,41 @@
+package rawdb
+
+import (
+	"encoding/json"
+
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/log"
+)
+
+// ReadGovernanceSnapshot retrieves the governance snapshot taken at the start
+// of the given round.
+func ReadGovernanceSnapshot(db DatabaseReader, round uint64) *types.GovernanceSnapshot {
+	data, _ := db.Get(govSnapshotKey(round))
+	if len(data) == 0 {
+		return nil
+	}
+	snapshot := new(types.GovernanceSnapshot)
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		log.Error("Invalid governance snapshot JSON", "round", round, "err", err)
+		return nil
+	}
+	return snapshot
+}
+
+// WriteGovernanceSnapshot stores the governance snapshot of a round.
+func WriteGovernanceSnapshot(db DatabaseWriter, snapshot *types.GovernanceSnapshot) {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		log.Crit("Failed to JSON encode governance snapshot", "err", err)
+	}
+	if err := db.Put(govSnapshotKey(snapshot.Round), data); err != nil {
+		log.Crit("Failed to store governance snapshot", "err", err)
+	}
+}
+
+// DeleteGovernanceSnapshot removes the governance snapshot of a round.
+func DeleteGovernanceSnapshot(db DatabaseDeleter, round uint64) {
+	if err := db.Delete(govSnapshotKey(round)); err != nil {
+		log.Crit("Failed to delete governance snapshot", "err", err)
+	}
+}
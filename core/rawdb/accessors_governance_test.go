@@ -0,0 +1,49 @@
+package rawdb
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/ethdb"
+	"github.com/dexon-foundation/dexon/params"
+)
+
+// Tests governance snapshot storage and retrieval operations.
+func TestGovernanceSnapshotStorage(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+
+	config := *params.TestnetChainConfig.Dexcon
+	snapshot := &types.GovernanceSnapshot{
+		Round:               7,
+		Height:              1234,
+		Config:              &config,
+		CRS:                 common.HexToHash("0x1234"),
+		NodePublicKeys:      []hexutil.Bytes{{0x04, 0x01}, {0x04, 0x02}},
+		DKGResetCount:       1,
+		DKGMasterPublicKeys: []hexutil.Bytes{{0x01}},
+		DKGComplaints:       []hexutil.Bytes{{0x02}},
+		DKGMPKReadysCount:   3,
+		DKGFinalizedsCount:  4,
+		DKGSuccessesCount:   5,
+	}
+	config.MiningVelocity = 0.1875
+	config.FineValues = []*big.Int{big.NewInt(1), big.NewInt(2)}
+
+	if entry := ReadGovernanceSnapshot(db, snapshot.Round); entry != nil {
+		t.Fatalf("Non existent governance snapshot returned: %v", entry)
+	}
+	WriteGovernanceSnapshot(db, snapshot)
+	if entry := ReadGovernanceSnapshot(db, snapshot.Round); entry == nil {
+		t.Fatalf("Stored governance snapshot not found")
+	} else if !reflect.DeepEqual(entry, snapshot) {
+		t.Fatalf("Retrieved governance snapshot mismatch: have %+v, want %+v", entry, snapshot)
+	}
+	DeleteGovernanceSnapshot(db, snapshot.Round)
+	if entry := ReadGovernanceSnapshot(db, snapshot.Round); entry != nil {
+		t.Fatalf("Deleted governance snapshot returned: %v", entry)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/ethdb"
+	"github.com/dexon-foundation/dexon/log"
+	"github.com/dexon-foundation/dexon/params"
+)
+
+// freezerdb is a database wrapper that enables the ancient store retrievals.
+type freezerdb struct {
+	ethdb.Database
+	*freezer
+}
+
+// Close implements ethdb.Database, closing both the ancient store and the
+// key-value store.
+func (frdb *freezerdb) Close() {
+	if err := frdb.freezer.Close(); err != nil {
+		log.Error("Failed to close ancient database", "err", err)
+	}
+	frdb.Database.Close()
+}
+
+// NewDatabaseWithFreezer wraps a key-value database with an ancient store in
+// the given directory. The blocks, receipts and total difficulties older than
+// params.ImmutabilityThreshold are moved into the ancient store in background,
+// and the chain accessors of this package fall back to the ancient store when
+// the data is not found in the key-value database.
+func NewDatabaseWithFreezer(db ethdb.Database, freezer string) (ethdb.Database, error) {
+	frdb, err := newFreezer(freezer, params.ImmutabilityThreshold)
+	if err != nil {
+		return nil, err
+	}
+	frdb.wg.Add(1)
+	go frdb.freeze(db)
+
+	return &freezerdb{
+		Database: db,
+		freezer:  frdb,
+	}, nil
+}
+
+// readAncient retrieves a blob of the canonical block of the given hash and
+// number from the ancient store, if the database has one.
+func readAncient(db DatabaseReader, kind string, hash common.Hash, number uint64) []byte {
+	reader, ok := db.(AncientReader)
+	if !ok {
+		return nil
+	}
+	if data, err := reader.Ancient(freezerHashTable, number); err != nil || common.BytesToHash(data) != hash {
+		return nil
+	}
+	data, _ := reader.Ancient(kind, number)
+	return data
+}
+
+// hasAncient returns whether the canonical block of the given hash and number
+// is in the ancient store, if the database has one.
+func hasAncient(db DatabaseReader, hash common.Hash, number uint64) bool {
+	return len(readAncient(db, freezerHashTable, hash, number)) != 0
+}
@@ -0,0 +1,342 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/ethdb"
+	"github.com/dexon-foundation/dexon/log"
+)
+
+// The ancient store tables.
+const (
+	// freezerHashTable indicates the name of the freezer canonical hash table.
+	freezerHashTable = "hashes"
+
+	// freezerHeaderTable indicates the name of the freezer header table.
+	freezerHeaderTable = "headers"
+
+	// freezerBodiesTable indicates the name of the freezer block body table.
+	freezerBodiesTable = "bodies"
+
+	// freezerReceiptTable indicates the name of the freezer receipts table.
+	freezerReceiptTable = "receipts"
+
+	// freezerDifficultyTable indicates the name of the freezer total difficulty table.
+	freezerDifficultyTable = "diffs"
+)
+
+var freezerTables = []string{
+	freezerHashTable,
+	freezerHeaderTable,
+	freezerBodiesTable,
+	freezerReceiptTable,
+	freezerDifficultyTable,
+}
+
+const (
+	// freezerRecheckInterval is the frequency to check the key-value database
+	// for chain progression that might permit new blocks to be frozen.
+	freezerRecheckInterval = time.Minute
+
+	// freezerBatchLimit is the maximum number of blocks to freeze in one batch
+	// before doing a sync and deleting the frozen data from the key-value store.
+	freezerBatchLimit = 30000
+)
+
+// errUnknownTable is returned if the user attempts to read from a table that
+// is not tracked by the freezer.
+var errUnknownTable = errors.New("unknown table")
+
+// freezer is the ancient store of the chain: a set of append-only flat file
+// tables holding the blocks, receipts and total difficulties of the canonical
+// chain older than a threshold, indexed by block number.
+type freezer struct {
+	frozen    uint64 // Number of blocks already frozen, accessed atomically
+	threshold uint64 // Number of recent blocks kept in the key-value store
+
+	tables map[string]*freezerTable
+	lock   sync.Mutex // Serializes the freezing and the truncation of blocks
+	quit   chan struct{}
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+// newFreezer opens the ancient store in the given directory, creating it if
+// it does not exist.
+func newFreezer(datadir string, threshold uint64) (*freezer, error) {
+	if err := os.MkdirAll(datadir, 0755); err != nil {
+		return nil, err
+	}
+	f := &freezer{
+		threshold: threshold,
+		tables:    make(map[string]*freezerTable),
+		quit:      make(chan struct{}),
+	}
+	for _, name := range freezerTables {
+		table, err := newFreezerTable(datadir, name)
+		if err != nil {
+			f.closeTables()
+			return nil, err
+		}
+		f.tables[name] = table
+	}
+	if err := f.repair(); err != nil {
+		f.closeTables()
+		return nil, err
+	}
+	log.Info("Opened ancient database", "database", datadir, "frozen", f.frozen)
+	return f, nil
+}
+
+// repair truncates all the tables to the same length, discarding the blocks
+// partially frozen before a crash.
+func (f *freezer) repair() error {
+	min := uint64(0)
+	for i, name := range freezerTables {
+		if items := f.tables[name].Items(); i == 0 || items < min {
+			min = items
+		}
+	}
+	for _, table := range f.tables {
+		if err := table.truncate(min); err != nil {
+			return err
+		}
+	}
+	atomic.StoreUint64(&f.frozen, min)
+	return nil
+}
+
+// HasAncient returns an indicator whether the specified ancient data exists
+// in the freezer.
+func (f *freezer) HasAncient(kind string, number uint64) (bool, error) {
+	if _, ok := f.tables[kind]; !ok {
+		return false, errUnknownTable
+	}
+	return number < atomic.LoadUint64(&f.frozen), nil
+}
+
+// Ancient retrieves an ancient binary blob from the append-only immutable files.
+func (f *freezer) Ancient(kind string, number uint64) ([]byte, error) {
+	table, ok := f.tables[kind]
+	if !ok {
+		return nil, errUnknownTable
+	}
+	if number >= atomic.LoadUint64(&f.frozen) {
+		return nil, errOutOfBounds
+	}
+	return table.Retrieve(number)
+}
+
+// Ancients returns the number of blocks frozen into the ancient store.
+func (f *freezer) Ancients() (uint64, error) {
+	return atomic.LoadUint64(&f.frozen), nil
+}
+
+// TruncateAncients discards the frozen blocks from the given number on, so
+// the ancient store does not outlive a rewind of the chain below it.
+func (f *freezer) TruncateAncients(items uint64) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if atomic.LoadUint64(&f.frozen) <= items {
+		return nil
+	}
+	atomic.StoreUint64(&f.frozen, items)
+	for _, name := range freezerTables {
+		if err := f.tables[name].truncate(items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendBlock injects all the data of a block into the ancient store. On
+// failure the partially written block is discarded.
+func (f *freezer) appendBlock(number uint64, hash common.Hash, header, body, receipts, td []byte) error {
+	blobs := map[string][]byte{
+		freezerHashTable:       hash.Bytes(),
+		freezerHeaderTable:     header,
+		freezerBodiesTable:     body,
+		freezerReceiptTable:    receipts,
+		freezerDifficultyTable: td,
+	}
+	for _, name := range freezerTables {
+		if err := f.tables[name].Append(number, blobs[name]); err != nil {
+			for _, table := range f.tables {
+				table.truncate(number)
+			}
+			return err
+		}
+	}
+	atomic.AddUint64(&f.frozen, 1)
+	return nil
+}
+
+// sync flushes all the tables to disk.
+func (f *freezer) sync() error {
+	for _, name := range freezerTables {
+		if err := f.tables[name].Sync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *freezer) closeTables() error {
+	var errs []error
+	for _, table := range f.tables {
+		if err := table.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if errs != nil {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
+
+// Close stops the background freezing and closes all the tables.
+func (f *freezer) Close() error {
+	var err error
+	f.once.Do(func() {
+		close(f.quit)
+		f.wg.Wait()
+		err = f.closeTables()
+	})
+	return err
+}
+
+// freeze is a background thread that periodically checks the blockchain for
+// any import progress and moves the blocks older than the immutability
+// threshold from the key-value database into the ancient store.
+func (f *freezer) freeze(db ethdb.Database) {
+	defer f.wg.Done()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-f.quit:
+			return
+		case <-timer.C:
+		}
+		if err := f.freezeBatch(db); err != nil {
+			log.Error("Failed to freeze blocks", "err", err)
+		}
+		timer.Reset(freezerRecheckInterval)
+	}
+}
+
+// freezeBatch moves a batch of blocks older than the immutability threshold
+// into the ancient store, then deletes them from the key-value database.
+func (f *freezer) freezeBatch(db ethdb.Database) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	head := ReadHeaderNumber(db, ReadHeadBlockHash(db))
+	if head == nil || *head < f.threshold {
+		return nil
+	}
+	var (
+		first  = atomic.LoadUint64(&f.frozen)
+		limit  = *head - f.threshold
+		hashes []common.Hash
+		start  = time.Now()
+	)
+	if limit >= first+freezerBatchLimit {
+		limit = first + freezerBatchLimit - 1
+	}
+	for number := first; number <= limit; number++ {
+		select {
+		case <-f.quit:
+			return nil
+		default:
+		}
+		hash := ReadCanonicalHash(db, number)
+		if hash == (common.Hash{}) {
+			return fmt.Errorf("canonical hash missing, can't freeze block %d", number)
+		}
+		header := ReadHeaderRLP(db, hash, number)
+		if len(header) == 0 {
+			return fmt.Errorf("block header missing, can't freeze block %d", number)
+		}
+		body := ReadBodyRLP(db, hash, number)
+		if len(body) == 0 {
+			return fmt.Errorf("block body missing, can't freeze block %d", number)
+		}
+		receipts, _ := db.Get(blockReceiptsKey(number, hash))
+		if len(receipts) == 0 {
+			return fmt.Errorf("block receipts missing, can't freeze block %d", number)
+		}
+		td, _ := db.Get(headerTDKey(number, hash))
+		if len(td) == 0 {
+			return fmt.Errorf("total difficulty missing, can't freeze block %d", number)
+		}
+		if err := f.appendBlock(number, hash, header, body, receipts, td); err != nil {
+			return err
+		}
+		hashes = append(hashes, hash)
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+	if err := f.sync(); err != nil {
+		return err
+	}
+
+	// The blocks are safely in the ancient store, delete them from the
+	// key-value database, except the genesis block.
+	batch := db.NewBatch()
+	for i, hash := range hashes {
+		number := first + uint64(i)
+		if number == 0 {
+			continue
+		}
+		for _, key := range [][]byte{
+			headerKey(number, hash),
+			headerTDKey(number, hash),
+			headerHashKey(number),
+			blockBodyKey(number, hash),
+			blockReceiptsKey(number, hash),
+		} {
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+		}
+		if batch.ValueSize() >= ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	log.Info("Moved blocks into ancient store", "blocks", len(hashes),
+		"frozen", atomic.LoadUint64(&f.frozen), "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/ethdb"
+)
+
+func TestFreezerTableRepair(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	table, err := newFreezerTable(dir, "test")
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	for i := byte(0); i < 10; i++ {
+		if err := table.Append(uint64(i), bytes.Repeat([]byte{i}, int(i)+1)); err != nil {
+			t.Fatalf("failed to append item %d: %v", i, err)
+		}
+	}
+	if err := table.Append(11, []byte{11}); err == nil {
+		t.Fatalf("out of order item appended")
+	}
+	table.Close()
+
+	// Cut the data file in the middle of the last item.
+	stat, err := os.Stat(filepath.Join(dir, "test.rdat"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(filepath.Join(dir, "test.rdat"), stat.Size()-1); err != nil {
+		t.Fatal(err)
+	}
+
+	table, err = newFreezerTable(dir, "test")
+	if err != nil {
+		t.Fatalf("failed to reopen table: %v", err)
+	}
+	defer table.Close()
+
+	if items := table.Items(); items != 9 {
+		t.Fatalf("item count mismatch after repair: have %d, want 9", items)
+	}
+	for i := byte(0); i < 9; i++ {
+		blob, err := table.Retrieve(uint64(i))
+		if err != nil {
+			t.Fatalf("failed to retrieve item %d: %v", i, err)
+		}
+		if !bytes.Equal(blob, bytes.Repeat([]byte{i}, int(i)+1)) {
+			t.Fatalf("item %d mismatch: %x", i, blob)
+		}
+	}
+	if _, err := table.Retrieve(9); err != errOutOfBounds {
+		t.Fatalf("error mismatch: have %v, want %v", err, errOutOfBounds)
+	}
+}
+
+func TestFreezeBlocks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db := ethdb.NewMemDatabase()
+	var blocks []*types.Block
+	for i := int64(0); i < 10; i++ {
+		header := &types.Header{Number: big.NewInt(i), Extra: []byte("test block")}
+		if i > 0 {
+			header.ParentHash = blocks[i-1].Hash()
+		}
+		block := types.NewBlockWithHeader(header)
+		receipts := types.Receipts{&types.Receipt{CumulativeGasUsed: uint64(i), Logs: []*types.Log{}}}
+
+		WriteBlock(db, block)
+		WriteReceipts(db, block.Hash(), block.NumberU64(), receipts)
+		WriteTd(db, block.Hash(), block.NumberU64(), big.NewInt(i))
+		WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		WriteHeadBlockHash(db, block.Hash())
+		blocks = append(blocks, block)
+	}
+
+	f, err := newFreezer(dir, 4)
+	if err != nil {
+		t.Fatalf("failed to create freezer: %v", err)
+	}
+	if err := f.freezeBatch(db); err != nil {
+		t.Fatalf("failed to freeze blocks: %v", err)
+	}
+	if frozen, _ := f.Ancients(); frozen != 6 {
+		t.Fatalf("frozen block count mismatch: have %d, want 6", frozen)
+	}
+	frdb := &freezerdb{Database: db, freezer: f}
+	defer frdb.Close()
+
+	for _, block := range blocks {
+		hash, number := block.Hash(), block.NumberU64()
+		if frozen := number < 6 && number > 0; frozen == HasHeader(db, hash, number) {
+			t.Errorf("block %d: key-value store presence mismatch", number)
+		}
+		if have := ReadCanonicalHash(frdb, number); have != hash {
+			t.Errorf("block %d: canonical hash mismatch: have %x, want %x", number, have, hash)
+		}
+		if have := ReadBlock(frdb, hash, number); have == nil || have.Hash() != hash {
+			t.Errorf("block %d: block mismatch: have %v", number, have)
+		}
+		if receipts := ReadReceipts(frdb, hash, number); len(receipts) != 1 || receipts[0].CumulativeGasUsed != number {
+			t.Errorf("block %d: receipts mismatch: have %v", number, receipts)
+		}
+		if td := ReadTd(frdb, hash, number); td == nil || td.Uint64() != number {
+			t.Errorf("block %d: total difficulty mismatch: have %v", number, td)
+		}
+		if ReadHeader(frdb, common.Hash{1}, number) != nil {
+			t.Errorf("block %d: header returned for unknown hash", number)
+		}
+	}
+}
+
+func TestTruncateAncients(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db := ethdb.NewMemDatabase()
+	var blocks []*types.Block
+	for i := int64(0); i < 10; i++ {
+		header := &types.Header{Number: big.NewInt(i), Extra: []byte("test block")}
+		if i > 0 {
+			header.ParentHash = blocks[i-1].Hash()
+		}
+		block := types.NewBlockWithHeader(header)
+
+		WriteBlock(db, block)
+		WriteReceipts(db, block.Hash(), block.NumberU64(), types.Receipts{})
+		WriteTd(db, block.Hash(), block.NumberU64(), big.NewInt(i))
+		WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		WriteHeadBlockHash(db, block.Hash())
+		blocks = append(blocks, block)
+	}
+
+	f, err := newFreezer(dir, 4)
+	if err != nil {
+		t.Fatalf("failed to create freezer: %v", err)
+	}
+	frdb := &freezerdb{Database: db, freezer: f}
+	defer frdb.Close()
+
+	if err := f.freezeBatch(db); err != nil {
+		t.Fatalf("failed to freeze blocks: %v", err)
+	}
+	// Rewind the chain to block 2, below the frozen blocks.
+	WriteHeadBlockHash(db, blocks[2].Hash())
+	if err := frdb.TruncateAncients(3); err != nil {
+		t.Fatalf("failed to truncate ancients: %v", err)
+	}
+	if frozen, _ := f.Ancients(); frozen != 3 {
+		t.Fatalf("frozen block count mismatch: have %d, want 3", frozen)
+	}
+	for _, block := range blocks[:6] {
+		hash, number := block.Hash(), block.NumberU64()
+		if have := ReadBlock(frdb, hash, number); (number < 3) != (have != nil) {
+			t.Errorf("block %d: block presence mismatch: have %v", number, have)
+		}
+	}
+	// Truncating beyond the frozen blocks is a no-op.
+	if err := frdb.TruncateAncients(5); err != nil {
+		t.Fatalf("failed to truncate ancients: %v", err)
+	}
+	if frozen, _ := f.Ancients(); frozen != 3 {
+		t.Errorf("frozen block count mismatch: have %d, want 3", frozen)
+	}
+	// The rewound chain is not frozen further until it grows again.
+	if err := f.freezeBatch(db); err != nil {
+		t.Fatalf("failed to freeze blocks: %v", err)
+	}
+	if frozen, _ := f.Ancients(); frozen != 3 {
+		t.Errorf("frozen block count mismatch: have %d, want 3", frozen)
+	}
+}
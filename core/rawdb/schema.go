@@ -50,7 +50,8 @@ var (
 	blockBodyPrefix     = []byte("b") // blockBodyPrefix + num (uint64 big endian) + hash -> block body
 	blockReceiptsPrefix = []byte("r") // blockReceiptsPrefix + num (uint64 big endian) + hash -> block receipts
 
-	govStatePrefix = []byte("g")
+	govStatePrefix    = []byte("g")
+	govSnapshotPrefix = []byte("G") // govSnapshotPrefix + round (uint64 big endian) -> governance snapshot
 
 	txLookupPrefix  = []byte("l") // txLookupPrefix + hash -> transaction/receipt lookup metadata
 	bloomBitsPrefix = []byte("B") // bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash -> bloom bits
@@ -124,6 +125,11 @@ func govStateKey(hash common.Hash) []byte {
 	return append(govStatePrefix, hash.Bytes()...)
 }
 
+// govSnapshotKey = govSnapshotPrefix + round (uint64 big endian)
+func govSnapshotKey(round uint64) []byte {
+	return append(govSnapshotPrefix, encodeBlockNumber(round)...)
+}
+
 // coreBlockKey = coreBlockPrefix + hash
 func coreBlockKey(hash common.Hash) []byte {
 	return append(coreBlockPrefix, hash.Bytes()...)
@@ -0,0 +1,89 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pruner
+
+import (
+	"encoding/binary"
+	"sync"
+)
+
+// bloomHashes is the number of bit positions set for every key. Since all
+// the keys are already keccak hashes, the positions are taken directly from
+// the key bytes.
+const bloomHashes = 4
+
+// stateBloom is a bloom filter of the state entries (trie nodes and contract
+// codes) that must survive the pruning. False positives only leave some
+// garbage in the database, false negatives can not happen.
+type stateBloom struct {
+	lock sync.RWMutex
+	bits []uint64
+}
+
+// newStateBloom creates a bloom filter occupying size bytes of memory.
+func newStateBloom(size uint64) *stateBloom {
+	if size < 8 {
+		size = 8
+	}
+	return &stateBloom{bits: make([]uint64, size/8)}
+}
+
+func (b *stateBloom) positions(key []byte) [bloomHashes]uint64 {
+	var (
+		pos [bloomHashes]uint64
+		n   = uint64(len(b.bits)) * 64
+	)
+	for i := 0; i < bloomHashes; i++ {
+		pos[i] = binary.BigEndian.Uint64(key[i*8:]) % n
+	}
+	return pos
+}
+
+// reset clears all the marks of the filter.
+func (b *stateBloom) reset() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for i := range b.bits {
+		b.bits[i] = 0
+	}
+}
+
+// add marks the given 32 byte key as live.
+func (b *stateBloom) add(key []byte) {
+	pos := b.positions(key)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, p := range pos {
+		b.bits[p/64] |= 1 << (p % 64)
+	}
+}
+
+// contains reports whether the given 32 byte key might have been marked.
+func (b *stateBloom) contains(key []byte) bool {
+	pos := b.positions(key)
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	for _, p := range pos {
+		if b.bits[p/64]&(1<<(p%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
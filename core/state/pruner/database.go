@@ -0,0 +1,79 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pruner
+
+import (
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/ethdb"
+)
+
+// markingDatabase is a database wrapper marking the state entries written
+// through it as live in the running pruning session.
+type markingDatabase struct {
+	ethdb.Database
+	pruner *Pruner
+}
+
+func (db *markingDatabase) Put(key []byte, value []byte) error {
+	db.pruner.lock.RLock()
+	defer db.pruner.lock.RUnlock()
+
+	db.pruner.mark(key)
+	return db.Database.Put(key, value)
+}
+
+func (db *markingDatabase) NewBatch() ethdb.Batch {
+	return &markingBatch{Batch: db.Database.NewBatch(), pruner: db.pruner}
+}
+
+// markingBatch is a batch marking the state entries written with it as live
+// in the running pruning session.
+type markingBatch struct {
+	ethdb.Batch
+	pruner *Pruner
+	keys   [][]byte
+}
+
+func (b *markingBatch) Put(key []byte, value []byte) error {
+	if len(key) == common.HashLength {
+		b.keys = append(b.keys, common.CopyBytes(key))
+	}
+	return b.Batch.Put(key, value)
+}
+
+func (b *markingBatch) Write() error {
+	b.pruner.lock.RLock()
+	defer b.pruner.lock.RUnlock()
+
+	for _, key := range b.keys {
+		b.pruner.mark(key)
+	}
+	return b.Batch.Write()
+}
+
+func (b *markingBatch) Reset() {
+	b.keys = b.keys[:0]
+	b.Batch.Reset()
+}
+
+// mark marks a state entry in the bloom filter of the running session. The
+// caller must hold the read lock.
+func (p *Pruner) mark(key []byte) {
+	if p.bloom != nil && len(key) == common.HashLength {
+		p.bloom.add(key)
+	}
+}
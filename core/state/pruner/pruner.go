@@ -0,0 +1,347 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package pruner implements a mark-and-sweep garbage collector of the
+// historical state stored in the chain database.
+package pruner
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core/state"
+	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/ethdb"
+	"github.com/dexon-foundation/dexon/log"
+	"github.com/dexon-foundation/dexon/rlp"
+	"github.com/dexon-foundation/dexon/trie"
+)
+
+const (
+	// sweepBatchKeys is the number of deletions collected before they are
+	// flushed to the database.
+	sweepBatchKeys = 10000
+
+	// abortCheckInterval is the number of marked nodes or swept keys after
+	// which the abort channel is polled.
+	abortCheckInterval = 100000
+
+	// maxVisitedNodes is the number of trie nodes per generation remembered
+	// to skip the shared subtrees of consecutive state roots.
+	maxVisitedNodes = 500000
+)
+
+var (
+	// emptyRoot is the known root hash of an empty trie.
+	emptyRoot = common.HexToHash("56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
+
+	// emptyCode is the known hash of the empty EVM bytecode.
+	emptyCode = crypto.Keccak256Hash(nil)
+)
+
+var (
+	// ErrPruningInProgress is returned if a pruning is started while another
+	// one is still running.
+	ErrPruningInProgress = errors.New("state pruning in progress")
+
+	// ErrAborted is returned if the pruning is aborted.
+	ErrAborted = errors.New("state pruning aborted")
+)
+
+// Pruner deletes all the trie nodes and contract codes from the database
+// which are not reachable from a set of retained state roots. Reachable
+// entries are marked in a bloom filter, then the whole database is swept.
+//
+// Pruning can run while the chain is importing blocks as long as the state
+// is committed through the database returned by Database, which marks the
+// entries written during a pruning session as live.
+type Pruner struct {
+	db        ethdb.Database
+	bloomSize uint64
+	filter    *stateBloom // Bloom filter allocated once and reused by every session
+
+	lock  sync.RWMutex // Lock serializing state writes with the sweep flushes
+	bloom *stateBloom  // Bloom filter of the running session, nil if idle
+}
+
+// NewPruner creates a pruner of the state in db, with a bloom filter of
+// bloomSize bytes.
+func NewPruner(db ethdb.Database, bloomSize uint64) *Pruner {
+	return &Pruner{
+		db:        db,
+		bloomSize: bloomSize,
+	}
+}
+
+// Database returns a database wrapper that the state of a running chain has
+// to be written through in order to be pruned online.
+func (p *Pruner) Database() ethdb.Database {
+	return &markingDatabase{Database: p.db, pruner: p}
+}
+
+// Start begins a pruning session. State entries written through Database
+// from now on are never deleted by the session.
+func (p *Pruner) Start() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.bloom != nil {
+		return ErrPruningInProgress
+	}
+	if p.filter == nil {
+		p.filter = newStateBloom(p.bloomSize)
+	} else {
+		p.filter.reset()
+	}
+	p.bloom = p.filter
+	return nil
+}
+
+// Prune marks the state reachable from the given roots, resolving the trie
+// nodes through triedb, and deletes every other state entry of the database.
+// The states of memoryRoots are held in memory by triedb and may be
+// dereferenced while being marked, all the other roots must be complete.
+// The session started by Start is finished when Prune returns.
+func (p *Pruner) Prune(triedb *trie.Database, roots, memoryRoots []common.Hash, abort <-chan struct{}) error {
+	p.lock.RLock()
+	bloom := p.bloom
+	p.lock.RUnlock()
+	if bloom == nil {
+		return errors.New("state pruning not started")
+	}
+	defer func() {
+		p.lock.Lock()
+		p.bloom = nil
+		p.lock.Unlock()
+	}()
+
+	start := time.Now()
+	m := &marker{
+		triedb:   triedb,
+		bloom:    bloom,
+		accounts: newNodeSet(maxVisitedNodes),
+		storages: newNodeSet(maxVisitedNodes),
+		abort:    abort,
+	}
+	for _, root := range roots {
+		if err := m.markState(root); err != nil {
+			return err
+		}
+	}
+	for _, root := range memoryRoots {
+		err := m.markState(root)
+		if _, ok := err.(*trie.MissingNodeError); ok {
+			if _, rerr := triedb.Node(root); rerr == nil {
+				// The root is still resolvable, so the state is broken.
+				return err
+			}
+			// The chain dereferenced the state from memory while it was
+			// being marked, its flushed nodes are marked on write.
+			log.Debug("Skipping dereferenced state", "root", root, "err", err)
+			m.accounts = newNodeSet(maxVisitedNodes)
+			m.storages = newNodeSet(maxVisitedNodes)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	log.Info("Marked live state", "roots", len(roots)+len(memoryRoots), "nodes", m.nodes,
+		"elapsed", common.PrettyDuration(time.Since(start)))
+
+	return p.sweep(bloom, abort)
+}
+
+// sweep deletes all the state entries not marked in bloom.
+func (p *Pruner) sweep(bloom *stateBloom, abort <-chan struct{}) error {
+	var (
+		start   = time.Now()
+		keys    [][]byte
+		swept   uint64
+		deleted int
+	)
+	flush := func() error {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		batch := p.db.NewBatch()
+		for _, key := range keys {
+			// The entry might have been rewritten by the chain after it was
+			// collected, check again under the lock.
+			if bloom.contains(key) {
+				continue
+			}
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+			deleted++
+		}
+		keys = keys[:0]
+		return batch.Write()
+	}
+
+	it := p.db.NewIteratorWithPrefix(nil)
+	defer it.Release()
+
+	for it.Next() {
+		swept++
+		if swept%abortCheckInterval == 0 {
+			select {
+			case <-abort:
+				return ErrAborted
+			default:
+			}
+		}
+		// State entries are keyed by their bare hash, all the other chain
+		// data is prefixed.
+		key := it.Key()
+		if len(key) != common.HashLength || bloom.contains(key) {
+			continue
+		}
+		keys = append(keys, common.CopyBytes(key))
+		if len(keys) >= sweepBatchKeys {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	log.Info("Swept stale state", "keys", swept, "deleted", deleted,
+		"elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// marker marks the trie nodes and codes reachable from state roots.
+type marker struct {
+	triedb   *trie.Database
+	bloom    *stateBloom
+	accounts *nodeSet // Account trie nodes with fully marked subtrees
+	storages *nodeSet // Storage trie nodes with fully marked subtrees
+	abort    <-chan struct{}
+	nodes    uint64
+}
+
+// markState marks the account trie of root, together with the storage tries
+// and codes of all the accounts.
+func (m *marker) markState(root common.Hash) error {
+	if _, err := m.triedb.Node(root); err != nil {
+		log.Debug("Skipping missing state root", "root", root)
+		return nil
+	}
+	err := m.markTrie(root, m.accounts, func(leaf []byte) error {
+		var account state.Account
+		if err := rlp.DecodeBytes(leaf, &account); err != nil {
+			return err
+		}
+		if account.Root != emptyRoot {
+			if err := m.markTrie(account.Root, m.storages, nil); err != nil {
+				return err
+			}
+		}
+		if codeHash := common.BytesToHash(account.CodeHash); codeHash != emptyCode {
+			m.bloom.add(codeHash[:])
+		}
+		return nil
+	})
+	m.accounts.rotate()
+	m.storages.rotate()
+	return err
+}
+
+// markTrie marks all the nodes of the trie of root, calling onLeaf with every
+// leaf value. The subtrees of nodes in visited are skipped.
+func (m *marker) markTrie(root common.Hash, visited *nodeSet, onLeaf func([]byte) error) error {
+	t, err := trie.New(root, m.triedb)
+	if err != nil {
+		return err
+	}
+	it := t.NodeIterator(nil)
+	for descend := true; it.Next(descend); {
+		descend = true
+		if it.Leaf() {
+			if onLeaf != nil {
+				if err := onLeaf(it.LeafBlob()); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		hash := it.Hash()
+		if hash == (common.Hash{}) {
+			// Embedded node, stored within its parent.
+			continue
+		}
+		// A node visited before has its whole subtree marked already.
+		if visited.has(hash) {
+			visited.add(hash)
+			descend = false
+			continue
+		}
+		visited.add(hash)
+		m.bloom.add(hash[:])
+
+		m.nodes++
+		if m.nodes%abortCheckInterval == 0 {
+			select {
+			case <-m.abort:
+				return ErrAborted
+			default:
+			}
+		}
+	}
+	return it.Error()
+}
+
+// nodeSet is a bounded set of trie nodes kept for two generations. It only
+// serves to skip work, so dropping entries never affects correctness.
+type nodeSet struct {
+	prev, cur map[common.Hash]struct{}
+	limit     int
+}
+
+func newNodeSet(limit int) *nodeSet {
+	return &nodeSet{
+		prev:  make(map[common.Hash]struct{}),
+		cur:   make(map[common.Hash]struct{}),
+		limit: limit,
+	}
+}
+
+func (s *nodeSet) has(hash common.Hash) bool {
+	if _, ok := s.cur[hash]; ok {
+		return true
+	}
+	_, ok := s.prev[hash]
+	return ok
+}
+
+func (s *nodeSet) add(hash common.Hash) {
+	if len(s.cur) < s.limit {
+		s.cur[hash] = struct{}{}
+	}
+}
+
+// rotate starts a new generation, forgetting the oldest one.
+func (s *nodeSet) rotate() {
+	s.prev, s.cur = s.cur, make(map[common.Hash]struct{})
+}
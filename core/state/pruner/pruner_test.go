@@ -0,0 +1,257 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pruner
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core/state"
+	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/ethdb"
+	"github.com/dexon-foundation/dexon/trie"
+)
+
+// makeState commits a state with some accounts, storage and code on top of
+// root and returns the new root.
+func makeState(t *testing.T, db state.Database, root common.Hash, seed byte) common.Hash {
+	statedb, err := state.New(root, db)
+	if err != nil {
+		t.Fatalf("failed to create state: %v", err)
+	}
+	for i := byte(0); i < 16; i++ {
+		addr := common.BytesToAddress([]byte{seed, i})
+		statedb.SetBalance(addr, big.NewInt(int64(seed)*100+int64(i)))
+		statedb.SetState(addr, common.Hash{i}, common.Hash{seed})
+		statedb.SetCode(addr, []byte{seed, i})
+	}
+	root, err = statedb.Commit(false)
+	if err != nil {
+		t.Fatalf("failed to commit state: %v", err)
+	}
+	if err := db.TrieDB().Commit(root, false); err != nil {
+		t.Fatalf("failed to write state: %v", err)
+	}
+	return root
+}
+
+// checkState iterates the whole state of root and returns the first error.
+func checkState(db ethdb.Database, root common.Hash) error {
+	statedb, err := state.New(root, state.NewDatabase(db))
+	if err != nil {
+		return err
+	}
+	it := state.NewNodeIterator(statedb)
+	for it.Next() {
+	}
+	return it.Error
+}
+
+func countStateEntries(db *ethdb.MemDatabase) int {
+	n := 0
+	for _, key := range db.Keys() {
+		if len(key) == common.HashLength {
+			n++
+		}
+	}
+	return n
+}
+
+func TestPrune(t *testing.T) {
+	diskdb := ethdb.NewMemDatabase()
+	sdb := state.NewDatabase(diskdb)
+
+	root1 := makeState(t, sdb, common.Hash{}, 1)
+	root2 := makeState(t, sdb, root1, 2)
+	root3 := makeState(t, sdb, root2, 3)
+
+	before := countStateEntries(diskdb)
+
+	p := NewPruner(diskdb, 1024*1024)
+	if err := p.Start(); err != nil {
+		t.Fatalf("failed to start pruning: %v", err)
+	}
+	if err := p.Start(); err != ErrPruningInProgress {
+		t.Fatalf("error mismatch: got %v, want %v", err, ErrPruningInProgress)
+	}
+	if err := p.Prune(trie.NewDatabase(diskdb), []common.Hash{root1, root3}, nil, nil); err != nil {
+		t.Fatalf("failed to prune: %v", err)
+	}
+
+	if after := countStateEntries(diskdb); after >= before {
+		t.Errorf("nothing pruned: %d entries before, %d after", before, after)
+	}
+	for _, root := range []common.Hash{root1, root3} {
+		if err := checkState(diskdb, root); err != nil {
+			t.Errorf("retained state %x is broken: %v", root, err)
+		}
+	}
+	if err := checkState(diskdb, root2); err == nil {
+		t.Errorf("state %x is not pruned", root2)
+	}
+}
+
+func TestPruneKeepsWrittenState(t *testing.T) {
+	diskdb := ethdb.NewMemDatabase()
+	p := NewPruner(diskdb, 1024*1024)
+	db := p.Database()
+
+	var (
+		stale   = crypto.Keccak256([]byte("stale"))
+		written = crypto.Keccak256([]byte("written"))
+		batched = crypto.Keccak256([]byte("batched"))
+	)
+	diskdb.Put(stale, []byte("stale"))
+
+	if err := p.Start(); err != nil {
+		t.Fatalf("failed to start pruning: %v", err)
+	}
+	// Entries written during the session must survive the sweep.
+	db.Put(written, []byte("written"))
+	batch := db.NewBatch()
+	batch.Put(batched, []byte("batched"))
+	if err := batch.Write(); err != nil {
+		t.Fatalf("failed to write batch: %v", err)
+	}
+	if err := p.Prune(trie.NewDatabase(diskdb), nil, nil, nil); err != nil {
+		t.Fatalf("failed to prune: %v", err)
+	}
+
+	if has, _ := diskdb.Has(stale); has {
+		t.Errorf("stale entry is not pruned")
+	}
+	for _, key := range [][]byte{written, batched} {
+		if has, _ := diskdb.Has(key); !has {
+			t.Errorf("entry %x written during pruning is deleted", key)
+		}
+	}
+}
+
+func TestPruneKeepsMemoryState(t *testing.T) {
+	diskdb := ethdb.NewMemDatabase()
+	sdb := state.NewDatabase(diskdb)
+	root1 := makeState(t, sdb, common.Hash{}, 1)
+
+	// Build a state on top of root1 which is only held in memory.
+	statedb, _ := state.New(root1, sdb)
+	statedb.SetBalance(common.Address{0xff}, big.NewInt(1))
+	root2, err := statedb.Commit(false)
+	if err != nil {
+		t.Fatalf("failed to commit state: %v", err)
+	}
+	p := NewPruner(diskdb, 1024*1024)
+	if err := p.Start(); err != nil {
+		t.Fatalf("failed to start pruning: %v", err)
+	}
+	if err := p.Prune(sdb.TrieDB(), nil, []common.Hash{root2}, nil); err != nil {
+		t.Fatalf("failed to prune: %v", err)
+	}
+	// The disk nodes shared with the memory state must survive.
+	if err := sdb.TrieDB().Commit(root2, false); err != nil {
+		t.Fatalf("failed to write state: %v", err)
+	}
+	if err := checkState(diskdb, root2); err != nil {
+		t.Errorf("memory state is broken: %v", err)
+	}
+}
+
+func TestPruneReusesBloom(t *testing.T) {
+	diskdb := ethdb.NewMemDatabase()
+	sdb := state.NewDatabase(diskdb)
+	root1 := makeState(t, sdb, common.Hash{}, 1)
+	root2 := makeState(t, sdb, root1, 2)
+
+	p := NewPruner(diskdb, 1024*1024)
+	for i, roots := range [][]common.Hash{{root1, root2}, {root2}} {
+		if err := p.Start(); err != nil {
+			t.Fatalf("session %d: failed to start pruning: %v", i, err)
+		}
+		if err := p.Prune(trie.NewDatabase(diskdb), roots, nil, nil); err != nil {
+			t.Fatalf("session %d: failed to prune: %v", i, err)
+		}
+	}
+	// Marks of the first session must not leak into the second one.
+	if err := checkState(diskdb, root1); err == nil {
+		t.Errorf("state %x is not pruned", root1)
+	}
+	if err := checkState(diskdb, root2); err != nil {
+		t.Errorf("retained state %x is broken: %v", root2, err)
+	}
+}
+
+func TestPruneAbortsOnMissingNode(t *testing.T) {
+	diskdb := ethdb.NewMemDatabase()
+	sdb := state.NewDatabase(diskdb)
+	root1 := makeState(t, sdb, common.Hash{}, 1)
+	root2 := makeState(t, sdb, root1, 2)
+
+	// Break the retained state by deleting a node below its root.
+	var victim []byte
+	tr, err := trie.New(root2, trie.NewDatabase(diskdb))
+	if err != nil {
+		t.Fatalf("failed to open trie: %v", err)
+	}
+	for nodes := tr.NodeIterator(nil); nodes.Next(true); {
+		if hash := nodes.Hash(); hash != (common.Hash{}) && hash != root2 {
+			victim = hash.Bytes()
+			break
+		}
+	}
+	diskdb.Delete(victim)
+	before := countStateEntries(diskdb)
+
+	p := NewPruner(diskdb, 1024*1024)
+	for i, roots := range [][2][]common.Hash{{{root2}, nil}, {nil, {root2}}} {
+		if err := p.Start(); err != nil {
+			t.Fatalf("case %d: failed to start pruning: %v", i, err)
+		}
+		err := p.Prune(trie.NewDatabase(diskdb), roots[0], roots[1], nil)
+		if _, ok := err.(*trie.MissingNodeError); !ok {
+			t.Fatalf("case %d: error mismatch: got %v, want missing node", i, err)
+		}
+		if after := countStateEntries(diskdb); after != before {
+			t.Errorf("case %d: broken state swept: %d entries before, %d after", i, before, after)
+		}
+	}
+}
+
+func TestPruneSkipsDereferencedState(t *testing.T) {
+	diskdb := ethdb.NewMemDatabase()
+	sdb := state.NewDatabase(diskdb)
+	root1 := makeState(t, sdb, common.Hash{}, 1)
+
+	// A memory state dereferenced before being marked is skipped.
+	statedb, _ := state.New(root1, sdb)
+	statedb.SetBalance(common.Address{0xff}, big.NewInt(1))
+	root2, err := statedb.Commit(false)
+	if err != nil {
+		t.Fatalf("failed to commit state: %v", err)
+	}
+	sdb.TrieDB().Dereference(root2)
+
+	p := NewPruner(diskdb, 1024*1024)
+	if err := p.Start(); err != nil {
+		t.Fatalf("failed to start pruning: %v", err)
+	}
+	if err := p.Prune(sdb.TrieDB(), []common.Hash{root1}, []common.Hash{root2}, nil); err != nil {
+		t.Fatalf("failed to prune: %v", err)
+	}
+	if err := checkState(diskdb, root1); err != nil {
+		t.Errorf("retained state %x is broken: %v", root1, err)
+	}
+}
@@ -18,7 +18,6 @@ package core
 
 import (
 	"errors"
-	"flag"
 	"math"
 	"math/big"
 	"sync/atomic"
@@ -30,9 +29,6 @@ import (
 	"github.com/dexon-foundation/dexon/params"
 )
 
-var legacyEvm = flag.Bool("legacy-evm", false, "make evm run origin logic")
-var TestingMode = false
-
 var (
 	errInsufficientBalanceForGas = errors.New("insufficient balance to pay for gas")
 )
@@ -191,18 +187,14 @@ func (st *StateTransition) preCheck() error {
 }
 
 func (st *StateTransition) inExtendedRound() bool {
-	// If we are running tests with chian_makers.go, there will be no valid
-	// blockchain instance for st.evm.StateAtNumber to work correctly. Simply
-	// return false in this case.
-	if TestingMode {
+	// Rounds are only defined by the Dexcon engine. Without a chain to read
+	// the round configuration from, e.g. when generating chains in tests,
+	// there is no extended round either.
+	if st.evm.ChainConfig().Dexcon == nil {
 		return false
 	}
-
-	if h := lastInExtendedRoundResultCache.Load(); h != nil {
-		res := h.(*lastInExtendedRoundResultType)
-		if res.Height == st.evm.BlockNumber.Uint64() {
-			return res.Result
-		}
+	if st.evm.GetRoundConfig == nil && st.evm.StateAtNumber == nil {
+		return false
 	}
 
 	gs := vm.GovernanceState{st.state}
@@ -214,14 +206,28 @@ func (st *StateTransition) inExtendedRound() bool {
 		round -= dexCore.ConfigRoundShift
 	}
 
-	configHeight := gs.RoundHeight(new(big.Int).SetUint64(round))
-	state, err := st.evm.StateAtNumber(configHeight.Uint64())
-	if err != nil {
-		panic(err)
+	var roundLength uint64
+	if st.evm.GetRoundConfig != nil {
+		roundLength = st.evm.GetRoundConfig(round).RoundLength
+	} else {
+		// Reading the round length from the historical state is expensive,
+		// cache the result of the block.
+		if h := lastInExtendedRoundResultCache.Load(); h != nil {
+			res := h.(*lastInExtendedRoundResultType)
+			if res.Height == st.evm.BlockNumber.Uint64() {
+				return res.Result
+			}
+		}
+		configHeight := gs.RoundHeight(new(big.Int).SetUint64(round))
+		state, err := st.evm.StateAtNumber(configHeight.Uint64())
+		if err != nil {
+			panic(err)
+		}
+		rgs := vm.GovernanceState{state}
+		roundLength = rgs.RoundLength().Uint64()
 	}
-	rgs := vm.GovernanceState{state}
 
-	roundEnd := gs.RoundHeight(st.evm.Round).Uint64() + rgs.RoundLength().Uint64()
+	roundEnd := gs.RoundHeight(st.evm.Round).Uint64() + roundLength
 
 	// Round 0 starts and height 0 instead of height 1.
 	if round == 0 {
@@ -230,10 +236,12 @@ func (st *StateTransition) inExtendedRound() bool {
 
 	res := st.evm.BlockNumber.Uint64() >= roundEnd
 
-	lastInExtendedRoundResultCache.Store(&lastInExtendedRoundResultType{
-		Height: st.evm.BlockNumber.Uint64(),
-		Result: res,
-	})
+	if st.evm.GetRoundConfig == nil {
+		lastInExtendedRoundResultCache.Store(&lastInExtendedRoundResultType{
+			Height: st.evm.BlockNumber.Uint64(),
+			Result: res,
+		})
+	}
 	return res
 }
 
@@ -282,14 +290,15 @@ func (st *StateTransition) TransitionDb() (ret []byte, usedGas uint64, failed bo
 		}
 	}
 
-	if *legacyEvm {
+	legacy := st.evm.ChainConfig().LegacyEVM
+	if legacy {
 		st.refundGas()
 	} else {
 		st.dexonRefundGas()
 	}
 
 	receiver := st.evm.Coinbase
-	if !*legacyEvm && st.inExtendedRound() {
+	if !legacy && st.inExtendedRound() {
 		gs := vm.GovernanceState{st.state}
 		receiver = gs.Owner()
 	}
@@ -4,6 +4,8 @@ import (
 	"math/big"
 
 	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/params"
 )
 
 type GovState struct {
@@ -18,3 +20,23 @@ type HeaderWithGovState struct {
 	*Header
 	GovState *GovState `rlp:"nil"`
 }
+
+// GovernanceSnapshot is the decoded governance contract state at the start
+// height of a round, persisted so that the round configuration, node set and
+// DKG results stay available after the historical state is pruned.
+type GovernanceSnapshot struct {
+	Round  uint64               `json:"round"`
+	Height uint64               `json:"height"`
+	Config *params.DexconConfig `json:"config"`
+	CRS    common.Hash          `json:"crs"`
+
+	// NodePublicKeys are the public keys of the qualified nodes.
+	NodePublicKeys []hexutil.Bytes `json:"nodePublicKeys"`
+
+	DKGResetCount       uint64          `json:"dkgResetCount"`
+	DKGMasterPublicKeys []hexutil.Bytes `json:"dkgMasterPublicKeys"`
+	DKGComplaints       []hexutil.Bytes `json:"dkgComplaints"`
+	DKGMPKReadysCount   uint64          `json:"dkgMPKReadysCount"`
+	DKGFinalizedsCount  uint64          `json:"dkgFinalizedsCount"`
+	DKGSuccessesCount   uint64          `json:"dkgSuccessesCount"`
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"math/big"
 
+	"github.com/dexon-foundation/bls/ffi/go/bls"
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/common/math"
 	"github.com/dexon-foundation/dexon/crypto"
@@ -59,6 +60,34 @@ var PrecompiledContractsByzantium = map[common.Address]PrecompiledContract{
 	common.BytesToAddress([]byte{8}): &bn256Pairing{},
 }
 
+// PrecompiledContractsDexon contains the default set of pre-compiled contracts
+// used after the BLS verification fork of DEXON.
+var PrecompiledContractsDexon = map[common.Address]PrecompiledContract{
+	common.BytesToAddress([]byte{1}):  &ecrecover{},
+	common.BytesToAddress([]byte{2}):  &sha256hash{},
+	common.BytesToAddress([]byte{3}):  &ripemd160hash{},
+	common.BytesToAddress([]byte{4}):  &dataCopy{},
+	common.BytesToAddress([]byte{5}):  &bigModExp{},
+	common.BytesToAddress([]byte{6}):  &bn256Add{},
+	common.BytesToAddress([]byte{7}):  &bn256ScalarMul{},
+	common.BytesToAddress([]byte{8}):  &bn256Pairing{},
+	common.BytesToAddress([]byte{9}):  &blsVerify{},
+	common.BytesToAddress([]byte{10}): &blsAggregateVerify{},
+}
+
+// ActivePrecompiledContracts returns the set of pre-compiled contracts
+// available at the given block.
+func ActivePrecompiledContracts(config *params.ChainConfig, num *big.Int) map[common.Address]PrecompiledContract {
+	switch {
+	case config.IsBLSVerify(num):
+		return PrecompiledContractsDexon
+	case config.IsByzantium(num):
+		return PrecompiledContractsByzantium
+	default:
+		return PrecompiledContractsHomestead
+	}
+}
+
 // RunPrecompiledContract runs and evaluates the output of a precompiled contract.
 func RunPrecompiledContract(p PrecompiledContract, input []byte, contract *Contract) (ret []byte, err error) {
 	gas := p.RequiredGas(input)
@@ -358,3 +387,125 @@ func (c *bn256Pairing) Run(input []byte) ([]byte, error) {
 	}
 	return false32Byte, nil
 }
+
+const (
+	// blsHashLength is the length of the message hash signed by BLS keys.
+	blsHashLength = 32
+	// blsPublicKeyLength is the length of a serialized BLS public key on the
+	// BLS12-381 curve used by the DEXON consensus.
+	blsPublicKeyLength = 96
+	// blsSignatureLength is the length of a serialized BLS signature.
+	blsSignatureLength = 48
+)
+
+var (
+	// errBadBLSInput is returned if the BLS verification input is invalid.
+	errBadBLSInput = errors.New("bad BLS verification input size")
+
+	// errBadBLSPublicKey is returned if a BLS public key cannot be decoded.
+	errBadBLSPublicKey = errors.New("invalid BLS public key")
+
+	// errBadBLSSignature is returned if a BLS signature cannot be decoded.
+	errBadBLSSignature = errors.New("invalid BLS signature")
+)
+
+// newBLSPublicKey decodes a serialized BLS public key. The bls library is
+// initialized with the consensus curve by the dkg package.
+func newBLSPublicKey(blob []byte) (*bls.PublicKey, error) {
+	pub := new(bls.PublicKey)
+	if err := pub.Deserialize(blob); err != nil {
+		return nil, errBadBLSPublicKey
+	}
+	return pub, nil
+}
+
+// newBLSSignature decodes a serialized BLS signature.
+func newBLSSignature(blob []byte) (*bls.Sign, error) {
+	sig := new(bls.Sign)
+	if err := sig.Deserialize(blob); err != nil {
+		return nil, errBadBLSSignature
+	}
+	return sig, nil
+}
+
+// blsVerify implements a BLS signature verification pre-compile on the curve
+// used by the DEXON consensus, e.g. for block randomness and CRS signatures.
+// The input is hash || public key || signature.
+type blsVerify struct{}
+
+// RequiredGas returns the gas required to execute the pre-compiled contract.
+func (c *blsVerify) RequiredGas(input []byte) uint64 {
+	return params.BLSVerifyGas
+}
+
+func (c *blsVerify) Run(input []byte) ([]byte, error) {
+	if len(input) != blsHashLength+blsPublicKeyLength+blsSignatureLength {
+		return nil, errBadBLSInput
+	}
+	pub, err := newBLSPublicKey(input[blsHashLength : blsHashLength+blsPublicKeyLength])
+	if err != nil {
+		return nil, err
+	}
+	sig, err := newBLSSignature(input[blsHashLength+blsPublicKeyLength:])
+	if err != nil {
+		return nil, err
+	}
+	if sig.Verify(pub, string(input[:blsHashLength])) {
+		return true32Byte, nil
+	}
+	return false32Byte, nil
+}
+
+// blsAggregateVerify implements a pre-compile verifying an aggregated BLS
+// signature of several public keys over the same hash. The input is
+// hash || signature || public key 1 || pop 1 || ... || public key n || pop n,
+// where each pop is the proof-of-possession of the preceding public key. The
+// proofs are verified before the keys are aggregated, so a rogue key derived
+// from honest keys cannot be used to forge the aggregate.
+type blsAggregateVerify struct{}
+
+// RequiredGas returns the gas required to execute the pre-compiled contract.
+func (c *blsAggregateVerify) RequiredGas(input []byte) uint64 {
+	if len(input) < blsHashLength+blsSignatureLength {
+		return params.BLSVerifyGas
+	}
+	keys := uint64(len(input)-blsHashLength-blsSignatureLength) / (blsPublicKeyLength + blsSignatureLength)
+	return params.BLSVerifyGas + keys*params.BLSAggregatePerKeyGas
+}
+
+func (c *blsAggregateVerify) Run(input []byte) ([]byte, error) {
+	const (
+		offset = blsHashLength + blsSignatureLength
+		size   = blsPublicKeyLength + blsSignatureLength
+	)
+	if len(input) <= offset || (len(input)-offset)%size != 0 {
+		return nil, errBadBLSInput
+	}
+	sig, err := newBLSSignature(input[blsHashLength:offset])
+	if err != nil {
+		return nil, err
+	}
+	var aggregated *bls.PublicKey
+	for i := offset; i < len(input); i += size {
+		pub, err := newBLSPublicKey(input[i : i+blsPublicKeyLength])
+		if err != nil {
+			return nil, err
+		}
+		pop, err := newBLSSignature(input[i+blsPublicKeyLength : i+size])
+		if err != nil {
+			return nil, err
+		}
+		if !pop.VerifyPop(pub) {
+			return false32Byte, nil
+		}
+		if aggregated == nil {
+			aggregated = pub
+		} else {
+			aggregated.Add(pub)
+		}
+	}
+	if sig.Verify(aggregated, string(input[:blsHashLength])) {
+		return true32Byte, nil
+	}
+	return false32Byte, nil
+}
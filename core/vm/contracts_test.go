@@ -21,6 +21,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/dexon-foundation/bls/ffi/go/bls"
 	"github.com/dexon-foundation/dexon/common"
 )
 
@@ -336,8 +337,83 @@ var bn256PairingTests = []precompiledTest{
 	},
 }
 
+// blsVerifyTests are the test and benchmark data for the BLS verification
+// precompiled contract, signed by the DEXON consensus library.
+var blsVerifyTests = []precompiledTest{
+	{
+		input: "fb96f6b6b6d11e465a80ca7592ee3f977c09db043abeb353cd06e2eae192880f" +
+			"6de0cfaf7ceff3351e0d9337f15f5778690bfa00fea8350501561134764a8bab595628695b15ead15bb2442fdaaa1e177642b326716d8b1d259d4249c10f6158b938848b6a3221f590ac502268418b70eac5c0ba8c92ef0d4fade6cec7c2e297" +
+			"396375568f16eb7c9d6a4112ed93ecc7c5f74078ce09c34c00b381567135e28ece40a1c7bfcc5b089140a98e56fec80f",
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+		name:     "valid_0",
+	}, {
+		input: "fb96f6b6b6d11e465a80ca7592ee3f977c09db043abeb353cd06e2eae192880f" +
+			"468debe670a7b729020f81c6745b5b97ff0d5f9f65214c0832a676f1620eac0b8ad6a63008f1015948eb0b8aa5964e0eb0496aed8e4aa4e08dbba701cc075b51fc29b1157b009bc93d9550bcea6b8da0d3e0afe59481d10ff4ebf020760ba900" +
+			"b81fd191009d4637887a374791347c833cb66a0ac781d5ac0b0f25264a7a888203eab7af050389c312ec975f8d1ec092",
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+		name:     "valid_1",
+	}, {
+		input: "fb96f6b6b6d11e465a80ca7592ee3f977c09db043abeb353cd06e2eae192880f" +
+			"6de0cfaf7ceff3351e0d9337f15f5778690bfa00fea8350501561134764a8bab595628695b15ead15bb2442fdaaa1e177642b326716d8b1d259d4249c10f6158b938848b6a3221f590ac502268418b70eac5c0ba8c92ef0d4fade6cec7c2e297" +
+			"b81fd191009d4637887a374791347c833cb66a0ac781d5ac0b0f25264a7a888203eab7af050389c312ec975f8d1ec092",
+		expected: "0000000000000000000000000000000000000000000000000000000000000000",
+		name:     "wrong_key",
+	}, {
+		input: "0000000000000000000000000000000000000000000000000000000000000000" +
+			"2acda52c7283ab42cf69316e293dd0be9b06ab25b34f1bee4c1839419dca336e88d8b31b5fc61801b6e80e2effbc3c12157a8d89eba8b168676b131babe11e57de6ce77df16b3ec0faaee4aa40b4f0e592db94d7844ced86c20cefcbe2bc6212" +
+			"4a3f71d30e1835c219c689d2bf2c01819aeda0e5141a149724ee6b6444447c4fde106965c0b576f55e67de702e634b12",
+		expected: "0000000000000000000000000000000000000000000000000000000000000000",
+		name:     "wrong_hash",
+	},
+}
+
+// blsAggregateVerifyTests are the test and benchmark data for the aggregated
+// BLS verification precompiled contract. Each public key is followed by its
+// proof-of-possession.
+var blsAggregateVerifyTests = []precompiledTest{
+	{
+		input: "fb96f6b6b6d11e465a80ca7592ee3f977c09db043abeb353cd06e2eae192880f" +
+			"a096ed531ea3be5cf0f3eccfb29f714f96197032445b019b568cecb964eeb778b47a7f7519216a9005223407ff180c06" +
+			"682f13d78fc2fe2e503835e1398a466f00271f7d4d395e29ed35ca6d0a74e8a5afc20065dd092c83c554e3c2486d890598933652684267a0f71b4ef59f52488a823fc70f5bd534a2c11b65171727a89291c337b23a92c1fff30aba9ba5bff68e" +
+			"d103f614d94f5774c70f55e8deb5cd7d952d797a174ff1c849f7d6f9213a585b21c3f297b7dba57b3945c2f9cd7a0e84" +
+			"b6ff9beb08cef5cf5048ab5f92510bf0670cf092b96d0d86d7cc7bd8821c9dd8308da31f9be1de396e1afb665ea72903208480279387e89b53e71bd8378917edd49b85b013db54f0e5603744027fde3385dbdfcf36f9092575552697cc999c94" +
+			"06fee508861a285fbc0759d548a9744a0442c316036b483c883d492d4c24f6a545e67ce360759982d9faa9ce1bf9cc0d" +
+			"fa2f63072174457cf7292f16f25576a52bdae9e5c84e41009e284f8aa26c5ddd94262203f007ee19ac345c2a0f7c1d034224946d6562987973f87aa30967815033c7908c98db55c0664494eebba80baad114f931462802f479527ca1bf8b8e92" +
+			"6f697818de235ab3af34dd924c1292cee55a28e81d43b9e46940b65afd3fac7cc15803f86ab90c87020c826c7e6b8603",
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+		name:     "three_keys",
+	}, {
+		input: "fb96f6b6b6d11e465a80ca7592ee3f977c09db043abeb353cd06e2eae192880f" +
+			"4bca28babc1a910492f71eefd52893197bac8ae00262fa6102a2be07375da793f9b1efa87f30ce93ef28381e26931713" +
+			"682f13d78fc2fe2e503835e1398a466f00271f7d4d395e29ed35ca6d0a74e8a5afc20065dd092c83c554e3c2486d890598933652684267a0f71b4ef59f52488a823fc70f5bd534a2c11b65171727a89291c337b23a92c1fff30aba9ba5bff68e" +
+			"d103f614d94f5774c70f55e8deb5cd7d952d797a174ff1c849f7d6f9213a585b21c3f297b7dba57b3945c2f9cd7a0e84",
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+		name:     "single_key",
+	}, {
+		input: "fb96f6b6b6d11e465a80ca7592ee3f977c09db043abeb353cd06e2eae192880f" +
+			"a096ed531ea3be5cf0f3eccfb29f714f96197032445b019b568cecb964eeb778b47a7f7519216a9005223407ff180c06" +
+			"682f13d78fc2fe2e503835e1398a466f00271f7d4d395e29ed35ca6d0a74e8a5afc20065dd092c83c554e3c2486d890598933652684267a0f71b4ef59f52488a823fc70f5bd534a2c11b65171727a89291c337b23a92c1fff30aba9ba5bff68e" +
+			"d103f614d94f5774c70f55e8deb5cd7d952d797a174ff1c849f7d6f9213a585b21c3f297b7dba57b3945c2f9cd7a0e84" +
+			"b6ff9beb08cef5cf5048ab5f92510bf0670cf092b96d0d86d7cc7bd8821c9dd8308da31f9be1de396e1afb665ea72903208480279387e89b53e71bd8378917edd49b85b013db54f0e5603744027fde3385dbdfcf36f9092575552697cc999c94" +
+			"06fee508861a285fbc0759d548a9744a0442c316036b483c883d492d4c24f6a545e67ce360759982d9faa9ce1bf9cc0d",
+		expected: "0000000000000000000000000000000000000000000000000000000000000000",
+		name:     "missing_key",
+	}, {
+		input: "fb96f6b6b6d11e465a80ca7592ee3f977c09db043abeb353cd06e2eae192880f" +
+			"a096ed531ea3be5cf0f3eccfb29f714f96197032445b019b568cecb964eeb778b47a7f7519216a9005223407ff180c06" +
+			"682f13d78fc2fe2e503835e1398a466f00271f7d4d395e29ed35ca6d0a74e8a5afc20065dd092c83c554e3c2486d890598933652684267a0f71b4ef59f52488a823fc70f5bd534a2c11b65171727a89291c337b23a92c1fff30aba9ba5bff68e" +
+			"d103f614d94f5774c70f55e8deb5cd7d952d797a174ff1c849f7d6f9213a585b21c3f297b7dba57b3945c2f9cd7a0e84" +
+			"b6ff9beb08cef5cf5048ab5f92510bf0670cf092b96d0d86d7cc7bd8821c9dd8308da31f9be1de396e1afb665ea72903208480279387e89b53e71bd8378917edd49b85b013db54f0e5603744027fde3385dbdfcf36f9092575552697cc999c94" +
+			"06fee508861a285fbc0759d548a9744a0442c316036b483c883d492d4c24f6a545e67ce360759982d9faa9ce1bf9cc0d" +
+			"fa2f63072174457cf7292f16f25576a52bdae9e5c84e41009e284f8aa26c5ddd94262203f007ee19ac345c2a0f7c1d034224946d6562987973f87aa30967815033c7908c98db55c0664494eebba80baad114f931462802f479527ca1bf8b8e92" +
+			"06fee508861a285fbc0759d548a9744a0442c316036b483c883d492d4c24f6a545e67ce360759982d9faa9ce1bf9cc0d",
+		expected: "0000000000000000000000000000000000000000000000000000000000000000",
+		name:     "wrong_pop",
+	},
+}
+
 func testPrecompiled(addr string, test precompiledTest, t *testing.T) {
-	p := PrecompiledContractsByzantium[common.HexToAddress(addr)]
+	p := PrecompiledContractsDexon[common.HexToAddress(addr)]
 	in := common.Hex2Bytes(test.input)
 	contract := NewContract(AccountRef(common.HexToAddress("1337")),
 		nil, new(big.Int), p.RequiredGas(in))
@@ -354,7 +430,7 @@ func benchmarkPrecompiled(addr string, test precompiledTest, bench *testing.B) {
 	if test.noBenchmark {
 		return
 	}
-	p := PrecompiledContractsByzantium[common.HexToAddress(addr)]
+	p := PrecompiledContractsDexon[common.HexToAddress(addr)]
 	in := common.Hex2Bytes(test.input)
 	reqGas := p.RequiredGas(in)
 	contract := NewContract(AccountRef(common.HexToAddress("1337")),
@@ -481,3 +557,90 @@ func BenchmarkPrecompiledBn256Pairing(bench *testing.B) {
 		benchmarkPrecompiled("08", test, bench)
 	}
 }
+
+// Tests the BLS signatures produced by the DEXON consensus library.
+func TestPrecompiledBLSVerify(t *testing.T) {
+	for _, test := range blsVerifyTests {
+		testPrecompiled("09", test, t)
+	}
+}
+
+// Benchmarks the BLS signatures produced by the DEXON consensus library.
+func BenchmarkPrecompiledBLSVerify(bench *testing.B) {
+	for _, test := range blsVerifyTests {
+		benchmarkPrecompiled("09", test, bench)
+	}
+}
+
+// Tests the aggregated BLS signatures produced by the DEXON consensus library.
+func TestPrecompiledBLSAggregateVerify(t *testing.T) {
+	for _, test := range blsAggregateVerifyTests {
+		testPrecompiled("0a", test, t)
+	}
+}
+
+// Benchmarks the aggregated BLS signatures produced by the DEXON consensus
+// library.
+func BenchmarkPrecompiledBLSAggregateVerify(bench *testing.B) {
+	for _, test := range blsAggregateVerifyTests {
+		benchmarkPrecompiled("0a", test, bench)
+	}
+}
+
+// Tests that malformed BLS verification inputs are rejected.
+func TestPrecompiledBLSVerifyBadInput(t *testing.T) {
+	valid := common.Hex2Bytes(blsVerifyTests[0].input)
+	invalidKey := common.CopyBytes(valid)
+	for i := blsHashLength; i < blsHashLength+blsPublicKeyLength; i++ {
+		invalidKey[i] = 0xff
+	}
+	tests := []struct {
+		addr  string
+		input []byte
+		err   error
+	}{
+		{"09", valid[:len(valid)-1], errBadBLSInput},
+		{"09", invalidKey, errBadBLSPublicKey},
+		{"0a", valid[:blsHashLength+blsSignatureLength], errBadBLSInput},
+		{"0a", append(common.CopyBytes(valid), 0), errBadBLSInput},
+	}
+	for i, test := range tests {
+		p := PrecompiledContractsDexon[common.HexToAddress(test.addr)]
+		if _, err := p.Run(test.input); err != test.err {
+			t.Errorf("test %d: expected error %v, got %v", i, test.err, err)
+		}
+	}
+}
+
+// Tests that a rogue key computed from an honest one, which would forge the
+// aggregate of the two keys, is rejected for lacking a proof-of-possession.
+func TestPrecompiledBLSAggregateVerifyRogueKey(t *testing.T) {
+	var honest, attacker bls.SecretKey
+	honest.SetByCSPRNG()
+	attacker.SetByCSPRNG()
+
+	// rogue = attacker - honest, evaluating [attacker, honest] at -1
+	var id bls.ID
+	if err := id.SetDecString("-1"); err != nil {
+		t.Fatalf("failed to set id: %v", err)
+	}
+	var rogue bls.PublicKey
+	if err := rogue.Set([]bls.PublicKey{*attacker.GetPublicKey(), *honest.GetPublicKey()}, &id); err != nil {
+		t.Fatalf("failed to derive rogue key: %v", err)
+	}
+	hash := common.HexToHash("fb96f6b6b6d11e465a80ca7592ee3f977c09db043abeb353cd06e2eae192880f")
+
+	input := append(hash.Bytes(), attacker.Sign(string(hash.Bytes())).Serialize()...)
+	input = append(input, honest.GetPublicKey().Serialize()...)
+	input = append(input, honest.GetPop().Serialize()...)
+	input = append(input, rogue.Serialize()...)
+	input = append(input, attacker.GetPop().Serialize()...)
+
+	out, err := PrecompiledContractsDexon[common.HexToAddress("0a")].Run(input)
+	if err != nil {
+		t.Fatalf("failed to run precompile: %v", err)
+	}
+	if common.Bytes2Hex(out) != common.Bytes2Hex(false32Byte) {
+		t.Fatalf("rogue aggregate accepted")
+	}
+}
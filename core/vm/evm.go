@@ -39,22 +39,24 @@ type (
 	// GetHashFunc returns the nth block hash in the blockchain
 	// and is used by the BLOCKHASH EVM op code.
 	GetHashFunc func(uint64) common.Hash
+	// GetRandomnessFunc returns the randomness of the nth block in the
+	// blockchain.
+	GetRandomnessFunc func(uint64) ([]byte, error)
 	// StateAtFunc returns the statedb given a root hash.
 	StateAtNumberFunc func(uint64) (*state.StateDB, error)
 	// GetRoundHeightFunc returns the round height.
 	GetRoundHeightFunc func(uint64) (uint64, bool)
+	// GetRoundConfigFunc returns the governance configuration of a round.
+	GetRoundConfigFunc func(uint64) *params.DexconConfig
 )
 
 // run runs the given contract and takes care of running precompiles with a fallback to the byte code interpreter.
 func run(evm *EVM, contract *Contract, input []byte, readOnly bool) ([]byte, error) {
 	if contract.CodeAddr != nil {
-		if o := OracleContracts[*contract.CodeAddr]; o != nil {
-			return RunOracleContract(o(), evm, input, contract)
-		}
-		precompiles := PrecompiledContractsHomestead
-		if evm.ChainConfig().IsByzantium(evm.BlockNumber) {
-			precompiles = PrecompiledContractsByzantium
+		if o := ActiveOracleContract(evm.ChainConfig(), evm.BlockNumber, *contract.CodeAddr); o != nil {
+			return RunOracleContract(o, evm, input, contract)
 		}
+		precompiles := ActivePrecompiledContracts(evm.ChainConfig(), evm.BlockNumber)
 		if p := precompiles[*contract.CodeAddr]; p != nil {
 			return RunPrecompiledContract(p, input, contract)
 		}
@@ -85,10 +87,14 @@ type Context struct {
 	Transfer TransferFunc
 	// GetHash returns the hash corresponding to n
 	GetHash GetHashFunc
+	// GetRandomness returns the block randomness corresponding to n
+	GetRandomness GetRandomnessFunc
 	// StateAtNumber returns the statedb given a root hash.
 	StateAtNumber StateAtNumberFunc
 	// GetRoundHeight returns the round height.
 	GetRoundHeight GetRoundHeightFunc
+	// GetRoundConfig returns the governance configuration of a round.
+	GetRoundConfig GetRoundConfigFunc
 
 	// Message information
 	Origin   common.Address // Provides information for ORIGIN
@@ -213,11 +219,9 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 		snapshot = evm.StateDB.Snapshot()
 	)
 	if !evm.StateDB.Exist(addr) {
-		precompiles := PrecompiledContractsHomestead
-		if evm.ChainConfig().IsByzantium(evm.BlockNumber) {
-			precompiles = PrecompiledContractsByzantium
-		}
-		if precompiles[addr] == nil && OracleContracts[addr] == nil &&
+		precompiles := ActivePrecompiledContracts(evm.ChainConfig(), evm.BlockNumber)
+		if precompiles[addr] == nil &&
+			ActiveOracleContract(evm.ChainConfig(), evm.BlockNumber, addr) == nil &&
 			evm.ChainConfig().IsEIP158(evm.BlockNumber) && value.Sign() == 0 {
 			// Calling a non existing account, don't do anything, but ping the tracer
 			if evm.vmConfig.Debug && evm.depth == 0 {
@@ -113,6 +113,7 @@ type StructLogger struct {
 	cfg LogConfig
 
 	logs          []StructLog
+	oracleFrames  []*OracleFrame
 	changedValues map[common.Address]Storage
 	output        []byte
 	err           error
@@ -203,6 +204,16 @@ func (l *StructLogger) CaptureEnd(output []byte, gasUsed uint64, t time.Duration
 	return nil
 }
 
+// CaptureOracle implements the OracleTracer interface to record the
+// execution of an oracle contract.
+func (l *StructLogger) CaptureOracle(env *EVM, frame *OracleFrame) error {
+	l.oracleFrames = append(l.oracleFrames, frame)
+	return nil
+}
+
+// OracleFrames returns the captured oracle contract frames.
+func (l *StructLogger) OracleFrames() []*OracleFrame { return l.oracleFrames }
+
 // StructLogs returns the captured log entries.
 func (l *StructLogger) StructLogs() []StructLog { return l.logs }
 
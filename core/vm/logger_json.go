@@ -72,6 +72,13 @@ func (l *JSONLogger) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint
 	return nil
 }
 
+// CaptureOracle outputs the oracle contract frame on the logger.
+func (l *JSONLogger) CaptureOracle(env *EVM, frame *OracleFrame) error {
+	return l.encoder.Encode(struct {
+		Oracle *OracleFrame `json:"oracle"`
+	}{frame})
+}
+
 // CaptureEnd is triggered at end of execution.
 func (l *JSONLogger) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
 	type endLog struct {
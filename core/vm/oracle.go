@@ -18,10 +18,13 @@
 package vm
 
 import (
+	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/dexon-foundation/dexon/accounts/abi"
 	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/params"
 )
 
 var GovernanceContractAddress = common.HexToAddress("63751838d6485578b23e8b051d40861ecc416794")
@@ -30,6 +33,16 @@ var GovernanceABI *OracleContractABI
 
 func init() {
 	GovernanceABI = NewOracleContractABI(GovernanceABIJSON)
+
+	RegisterOracleContract(&OracleContractSpec{
+		Address: GovernanceContractAddress,
+		ABI:     GovernanceABI,
+		New: func() OracleContract {
+			return &GovernanceContract{
+				coreDKGUtils: &defaultCoreDKGUtils{},
+			}
+		},
+	})
 }
 
 // OracleContract represent special system contracts written in Go.
@@ -37,17 +50,113 @@ type OracleContract interface {
 	Run(evm *EVM, input []byte, contract *Contract) (ret []byte, err error)
 }
 
+// OracleContractSpec describes an oracle contract and when it is available.
+type OracleContractSpec struct {
+	// Address is the address the contract is deployed at.
+	Address common.Address
+	// ABI is the ABI of the contract, used for gas charging and tracing.
+	ABI *OracleContractABI
+	// Fork returns the activation block of the contract from the chain
+	// configuration, a nil result means the contract is not activated. A nil
+	// Fork means the contract is available since genesis.
+	Fork func(config *params.ChainConfig) *big.Int
+	// GasTable is the gas charged for each method before it is executed.
+	GasTable map[string]uint64
+	// New creates an instance of the contract for a single execution.
+	New func() OracleContract
+}
+
+// IsActive returns whether the contract is available at the given block.
+func (s *OracleContractSpec) IsActive(config *params.ChainConfig, num *big.Int) bool {
+	if s.Fork == nil {
+		return true
+	}
+	if config == nil {
+		return false
+	}
+	return isOracleForked(s.Fork(config), num)
+}
+
+// isActivated returns whether the contract is activated exactly at the given
+// block.
+func (s *OracleContractSpec) isActivated(config *params.ChainConfig, num *big.Int) bool {
+	if s.Fork == nil || config == nil {
+		return false
+	}
+	fork := s.Fork(config)
+	return fork != nil && fork.Cmp(num) == 0
+}
+
+func isOracleForked(fork, num *big.Int) bool {
+	if fork == nil || num == nil {
+		return false
+	}
+	return fork.Cmp(num) <= 0
+}
+
+// oracleContractCode is the placeholder code of oracle contract accounts so
+// that they are recognized as contracts by the EVM and by callers checking
+// the code size.
+var oracleContractCode = []byte{0xed}
+
 // A map representing available system oracle contracts.
-var OracleContracts = map[common.Address]func() OracleContract{
-	GovernanceContractAddress: func() OracleContract {
-		return &GovernanceContract{
-			coreDKGUtils: &defaultCoreDKGUtils{},
+var OracleContracts = map[common.Address]*OracleContractSpec{}
+
+// RegisterOracleContract registers an oracle contract. It panics if another
+// contract is already registered at the same address.
+func RegisterOracleContract(spec *OracleContractSpec) {
+	if _, exists := OracleContracts[spec.Address]; exists {
+		panic(fmt.Sprintf("oracle contract %x already registered", spec.Address))
+	}
+	OracleContracts[spec.Address] = spec
+}
+
+// ActiveOracleContract returns the oracle contract at the address if it is
+// available at the given block.
+func ActiveOracleContract(config *params.ChainConfig, num *big.Int, addr common.Address) *OracleContractSpec {
+	spec := OracleContracts[addr]
+	if spec == nil || !spec.IsActive(config, num) {
+		return nil
+	}
+	return spec
+}
+
+// InitOracleContracts installs the code of oracle contracts available at
+// genesis into the state.
+func InitOracleContracts(config *params.ChainConfig, statedb StateDB) {
+	for addr, spec := range OracleContracts {
+		if spec.IsActive(config, big.NewInt(0)) {
+			statedb.SetCode(addr, oracleContractCode)
 		}
-	},
+	}
+}
+
+// ActivateOracleContracts installs the code of oracle contracts activated at
+// the given block into the state. It must be called before the transactions
+// of the block are applied.
+func ActivateOracleContracts(config *params.ChainConfig, num *big.Int, statedb StateDB) {
+	for addr, spec := range OracleContracts {
+		if spec.isActivated(config, num) {
+			statedb.SetCode(addr, oracleContractCode)
+		}
+	}
 }
 
 // Run oracle contract.
-func RunOracleContract(oracle OracleContract, evm *EVM, input []byte, contract *Contract) (ret []byte, err error) {
+func RunOracleContract(spec *OracleContractSpec, evm *EVM, input []byte, contract *Contract) (ret []byte, err error) {
+	if spec.ABI != nil && len(input) >= 4 {
+		if method, exists := spec.ABI.Sig2Method[string(input[:4])]; exists {
+			if !contract.UseGas(spec.GasTable[method.Name]) {
+				return nil, ErrOutOfGas
+			}
+		}
+	}
+	oracle := spec.New()
+	if evm.vmConfig.Debug {
+		if tracer, ok := evm.vmConfig.Tracer.(OracleTracer); ok {
+			return runTracedOracleContract(tracer, spec, oracle, evm, input, contract)
+		}
+	}
 	return oracle.Run(evm, input, contract)
 }
 
@@ -1219,3 +1219,65 @@ const GovernanceABIJSON = `
   }
 ]
 `
+
+const RandomnessBeaconABIJSON = `
+[
+  {
+    "constant": true,
+    "inputs": [
+      {
+        "name": "Height",
+        "type": "uint256"
+      }
+    ],
+    "name": "randomness",
+    "outputs": [
+      {
+        "name": "",
+        "type": "bytes"
+      }
+    ],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [
+      {
+        "name": "Round",
+        "type": "uint256"
+      }
+    ],
+    "name": "crs",
+    "outputs": [
+      {
+        "name": "",
+        "type": "bytes32"
+      }
+    ],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [
+      {
+        "name": "Round",
+        "type": "uint256"
+      }
+    ],
+    "name": "groupPublicKey",
+    "outputs": [
+      {
+        "name": "",
+        "type": "bytes"
+      }
+    ],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  }
+]
+`
@@ -99,7 +99,9 @@ const (
 	finedRecordsLoc
 )
 
-func publicKeyToNodeKeyAddress(pkBytes []byte) (common.Address, error) {
+// PublicKeyToNodeKeyAddress derives the address a node is indexed by from its
+// public key.
+func PublicKeyToNodeKeyAddress(pkBytes []byte) (common.Address, error) {
 	pk, err := crypto.UnmarshalPubkey(pkBytes)
 	if err != nil {
 		return common.Address{}, err
@@ -529,7 +531,7 @@ func (s *GovernanceState) DeleteNodesOffsetByNodeKeyAddress(addr common.Address)
 }
 
 func (s *GovernanceState) PutNodeOffsets(n *nodeInfo, offset *big.Int) {
-	address, err := publicKeyToNodeKeyAddress(n.PublicKey)
+	address, err := PublicKeyToNodeKeyAddress(n.PublicKey)
 	if err != nil {
 		panic(err)
 	}
@@ -537,7 +539,7 @@ func (s *GovernanceState) PutNodeOffsets(n *nodeInfo, offset *big.Int) {
 	s.PutNodesOffsetByAddress(n.Owner, offset)
 }
 func (s *GovernanceState) DeleteNodeOffsets(n *nodeInfo) {
-	address, err := publicKeyToNodeKeyAddress(n.PublicKey)
+	address, err := PublicKeyToNodeKeyAddress(n.PublicKey)
 	if err != nil {
 		panic(err)
 	}
@@ -999,7 +1001,7 @@ func (s *GovernanceState) Register(
 }
 
 func (s *GovernanceState) Disqualify(n *nodeInfo) error {
-	nodeAddr, err := publicKeyToNodeKeyAddress(n.PublicKey)
+	nodeAddr, err := PublicKeyToNodeKeyAddress(n.PublicKey)
 	if err != nil {
 		return err
 	}
@@ -1042,6 +1044,31 @@ func (s *GovernanceState) Configuration() *params.DexconConfig {
 	}
 }
 
+// Snapshot returns the decoded governance state, which is expected to be the
+// state at the start height of the given round.
+func (s *GovernanceState) Snapshot(round, height uint64) *types.GovernanceSnapshot {
+	snapshot := &types.GovernanceSnapshot{
+		Round:              round,
+		Height:             height,
+		Config:             s.Configuration(),
+		CRS:                s.CRS(),
+		DKGResetCount:      s.DKGResetCount(new(big.Int).SetUint64(round)).Uint64(),
+		DKGMPKReadysCount:  s.DKGMPKReadysCount().Uint64(),
+		DKGFinalizedsCount: s.DKGFinalizedsCount().Uint64(),
+		DKGSuccessesCount:  s.DKGSuccessesCount().Uint64(),
+	}
+	for _, n := range s.QualifiedNodes() {
+		snapshot.NodePublicKeys = append(snapshot.NodePublicKeys, n.PublicKey)
+	}
+	for _, mpk := range s.DKGMasterPublicKeys() {
+		snapshot.DKGMasterPublicKeys = append(snapshot.DKGMasterPublicKeys, mpk)
+	}
+	for _, complaint := range s.DKGComplaints() {
+		snapshot.DKGComplaints = append(snapshot.DKGComplaints, complaint)
+	}
+	return snapshot
+}
+
 // UpdateConfiguration updates system configuration.
 func (s *GovernanceState) UpdateConfiguration(cfg *params.DexconConfig) {
 	s.setStateBigInt(big.NewInt(minStakeLoc), cfg.MinStake)
@@ -1254,6 +1281,10 @@ func getRoundState(evm *EVM, round *big.Int) (*GovernanceState, error) {
 			return nil, errExecutionReverted
 		}
 	}
+	// There is no chain to read the state from, e.g. in state tests.
+	if evm.StateAtNumber == nil {
+		return nil, errExecutionReverted
+	}
 	statedb, err := evm.StateAtNumber(height)
 	return &GovernanceState{statedb}, err
 }
@@ -1322,6 +1353,16 @@ func (g *GovernanceContract) transfer(from, to common.Address, amount *big.Int)
 	return false
 }
 
+// revert aborts the execution with the reason encoded as Solidity compatible
+// Error(string) revert data. Before the revert reason fork the reason is not
+// returned, as it changes the return data visible to the calling contracts.
+func (g *GovernanceContract) revert(reason string) ([]byte, error) {
+	if !g.evm.ChainConfig().IsRevertReason(g.evm.BlockNumber) {
+		return nil, errExecutionReverted
+	}
+	return abi.PackRevert(reason), errExecutionReverted
+}
+
 func (g *GovernanceContract) useGas(gas uint64) ([]byte, error) {
 	if !g.contract.UseGas(gas) {
 		return nil, ErrOutOfGas
@@ -1455,12 +1496,12 @@ func (g *GovernanceContract) addDKGComplaint(comp []byte) ([]byte, error) {
 
 	// Can not add complaint if caller does not exists.
 	if offset.Cmp(big.NewInt(0)) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("caller is not a registered node")
 	}
 
 	// Finalized caller is not allowed to propose complaint.
 	if g.state.DKGFinalized(caller) {
-		return nil, errExecutionReverted
+		return g.revert("caller already finalized DKG")
 	}
 
 	// Calculate 2f + 1
@@ -1468,34 +1509,34 @@ func (g *GovernanceContract) addDKGComplaint(comp []byte) ([]byte, error) {
 
 	// If 2f + 1 of DKG set is finalized, one can not propose complaint anymore.
 	if g.state.DKGFinalizedsCount().Uint64() >= threshold {
-		return nil, errExecutionReverted
+		return g.revert("DKG already finalized")
 	}
 
 	var dkgComplaint dkgTypes.Complaint
 	if err := rlp.DecodeBytes(comp, &dkgComplaint); err != nil {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG complaint")
 	}
 
 	if g.state.DKGComplaintProposed(getDKGComplaintID(&dkgComplaint)) {
-		return nil, errExecutionReverted
+		return g.revert("DKG complaint already proposed")
 	}
 	round := big.NewInt(int64(dkgComplaint.Round))
 	if round.Uint64() != g.evm.Round.Uint64()+1 {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG round")
 	}
 
 	if dkgComplaint.Reset != g.state.DKGResetCount(round).Uint64() {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG reset count")
 	}
 
 	// DKGComplaint must belongs to someone in DKG set.
 	if !g.inNotarySet(round, dkgComplaint.ProposerID) {
-		return nil, errExecutionReverted
+		return g.revert("proposer not in notary set")
 	}
 
 	verified, _ := coreUtils.VerifyDKGComplaintSignature(&dkgComplaint)
 	if !verified {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG complaint signature")
 	}
 
 	mpkOffset := g.state.DKGMasterPublicKeyOffset(Bytes32(dkgComplaint.PrivateShare.ProposerID.Hash))
@@ -1504,22 +1545,22 @@ func (g *GovernanceContract) addDKGComplaint(comp []byte) ([]byte, error) {
 	// Verify DKG complaint is correct.
 	ok, err := coreUtils.VerifyDKGComplaint(&dkgComplaint, mpk)
 	if !ok || err != nil {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG complaint")
 	}
 
 	// Fine the attacker.
 	need, err := coreUtils.NeedPenaltyDKGPrivateShare(&dkgComplaint, mpk)
 	if err != nil {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG complaint")
 	}
 	if need {
 		node, err := g.state.GetNodeByID(dkgComplaint.PrivateShare.ProposerID)
 		if err != nil {
-			return nil, errExecutionReverted
+			return g.revert("fined node not found")
 		}
 		fineValue := g.state.FineValue(big.NewInt(FineTypeInvalidDKG))
 		if err := g.fine(node.Owner, fineValue, comp, nil); err != nil {
-			return nil, errExecutionReverted
+			return g.revert(err.Error())
 		}
 	}
 
@@ -1532,11 +1573,11 @@ func (g *GovernanceContract) addDKGComplaint(comp []byte) ([]byte, error) {
 func (g *GovernanceContract) addDKGMasterPublicKey(mpk []byte) ([]byte, error) {
 	var dkgMasterPK dkgTypes.MasterPublicKey
 	if err := rlp.DecodeBytes(mpk, &dkgMasterPK); err != nil {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG master public key")
 	}
 	round := big.NewInt(int64(dkgMasterPK.Round))
 	if round.Uint64() != g.evm.Round.Uint64()+1 {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG round")
 	}
 
 	if g.state.DKGRound().Cmp(g.evm.Round) == 0 {
@@ -1547,7 +1588,7 @@ func (g *GovernanceContract) addDKGMasterPublicKey(mpk []byte) ([]byte, error) {
 
 	mpkOffset := g.state.DKGMasterPublicKeyOffset(getDKGMasterPublicKeyID(&dkgMasterPK))
 	if mpkOffset.Cmp(big.NewInt(0)) >= 0 {
-		return nil, errExecutionReverted
+		return g.revert("DKG master public key already proposed")
 	}
 
 	caller := g.contract.Caller()
@@ -1555,12 +1596,12 @@ func (g *GovernanceContract) addDKGMasterPublicKey(mpk []byte) ([]byte, error) {
 
 	// Can not add dkg mpk if not staked.
 	if offset.Cmp(big.NewInt(0)) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("caller is not a registered node")
 	}
 
 	// MPKReady caller is not allowed to propose mpk.
 	if g.state.DKGMPKReady(caller) {
-		return nil, errExecutionReverted
+		return g.revert("caller already proposed MPK ready")
 	}
 
 	// Calculate 2f + 1
@@ -1568,21 +1609,21 @@ func (g *GovernanceContract) addDKGMasterPublicKey(mpk []byte) ([]byte, error) {
 
 	// If 2f + 1 of DKG set is mpk ready, one can not propose mpk anymore.
 	if g.state.DKGMPKReadysCount().Uint64() >= threshold {
-		return nil, errExecutionReverted
+		return g.revert("DKG MPK ready already reached threshold")
 	}
 
 	if dkgMasterPK.Reset != g.state.DKGResetCount(round).Uint64() {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG reset count")
 	}
 
 	// DKGMasterPublicKey must belongs to someone in DKG set.
 	if !g.inNotarySet(round, dkgMasterPK.ProposerID) {
-		return nil, errExecutionReverted
+		return g.revert("proposer not in notary set")
 	}
 
 	verified, _ := coreUtils.VerifyDKGMasterPublicKeySignature(&dkgMasterPK)
 	if !verified {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG master public key signature")
 	}
 
 	mpkOffset = g.state.LenDKGMasterPublicKeys()
@@ -1597,25 +1638,25 @@ func (g *GovernanceContract) addDKGMPKReady(ready []byte) ([]byte, error) {
 
 	var dkgReady dkgTypes.MPKReady
 	if err := rlp.DecodeBytes(ready, &dkgReady); err != nil {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG MPK ready")
 	}
 	round := big.NewInt(int64(dkgReady.Round))
 	if round.Uint64() != g.evm.Round.Uint64()+1 {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG round")
 	}
 
 	if dkgReady.Reset != g.state.DKGResetCount(round).Uint64() {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG reset count")
 	}
 
 	// DKGFInalize must belongs to someone in DKG set.
 	if !g.inNotarySet(round, dkgReady.ProposerID) {
-		return nil, errExecutionReverted
+		return g.revert("proposer not in notary set")
 	}
 
 	verified, _ := coreUtils.VerifyDKGMPKReadySignature(&dkgReady)
 	if !verified {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG MPK ready signature")
 	}
 
 	if !g.state.DKGMPKReady(caller) {
@@ -1631,25 +1672,25 @@ func (g *GovernanceContract) addDKGFinalize(finalize []byte) ([]byte, error) {
 
 	var dkgFinalize dkgTypes.Finalize
 	if err := rlp.DecodeBytes(finalize, &dkgFinalize); err != nil {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG finalize")
 	}
 	round := big.NewInt(int64(dkgFinalize.Round))
 	if round.Uint64() != g.evm.Round.Uint64()+1 {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG round")
 	}
 
 	if dkgFinalize.Reset != g.state.DKGResetCount(round).Uint64() {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG reset count")
 	}
 
 	// DKGFInalize must belongs to someone in DKG set.
 	if !g.inNotarySet(round, dkgFinalize.ProposerID) {
-		return nil, errExecutionReverted
+		return g.revert("proposer not in notary set")
 	}
 
 	verified, _ := coreUtils.VerifyDKGFinalizeSignature(&dkgFinalize)
 	if !verified {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG finalize signature")
 	}
 
 	if !g.state.DKGFinalized(caller) {
@@ -1673,25 +1714,25 @@ func (g *GovernanceContract) addDKGSuccess(success []byte) ([]byte, error) {
 
 	var dkgSuccess dkgTypes.Success
 	if err := rlp.DecodeBytes(success, &dkgSuccess); err != nil {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG success")
 	}
 	round := big.NewInt(int64(dkgSuccess.Round))
 	if round.Uint64() != g.evm.Round.Uint64()+1 {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG round")
 	}
 
 	if dkgSuccess.Reset != g.state.DKGResetCount(round).Uint64() {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG reset count")
 	}
 
 	// DKGFInalize must belongs to someone in DKG set.
 	if !g.inNotarySet(round, dkgSuccess.ProposerID) {
-		return nil, errExecutionReverted
+		return g.revert("proposer not in notary set")
 	}
 
 	verified, _ := coreUtils.VerifyDKGSuccessSignature(&dkgSuccess)
 	if !verified {
-		return nil, errExecutionReverted
+		return g.revert("invalid DKG success signature")
 	}
 
 	if !g.state.DKGSuccess(caller) {
@@ -1705,7 +1746,7 @@ func (g *GovernanceContract) addDKGSuccess(success []byte) ([]byte, error) {
 func (g *GovernanceContract) updateConfiguration(cfg *rawConfigStruct) ([]byte, error) {
 	// Only owner can update configuration.
 	if g.contract.Caller() != g.state.Owner() {
-		return nil, errExecutionReverted
+		return g.revert("caller is not the owner")
 	}
 
 	// Sanity checks.
@@ -1717,7 +1758,7 @@ func (g *GovernanceContract) updateConfiguration(cfg *rawConfigStruct) ([]byte,
 		cfg.LambdaDKG.Cmp(big.NewInt(0)) <= 0 ||
 		cfg.RoundLength.Cmp(big.NewInt(0)) <= 0 ||
 		cfg.MinBlockInterval.Cmp(big.NewInt(0)) <= 0 {
-		return nil, errExecutionReverted
+		return g.revert("invalid configuration")
 	}
 
 	g.state.UpdateConfigurationRaw(cfg)
@@ -1731,7 +1772,7 @@ func (g *GovernanceContract) register(
 
 	// Reject invalid inputs.
 	if len(name) >= 32 || len(email) >= 32 || len(location) >= 32 || len(url) >= 128 {
-		return nil, errExecutionReverted
+		return g.revert("input too long")
 	}
 
 	caller := g.contract.Caller()
@@ -1740,19 +1781,19 @@ func (g *GovernanceContract) register(
 
 	// Can not register if already registered.
 	if offset.Cmp(big.NewInt(0)) >= 0 {
-		return nil, errExecutionReverted
+		return g.revert("caller already registered")
 	}
 
-	nodeKeyAddr, err := publicKeyToNodeKeyAddress(publicKey)
+	nodeKeyAddr, err := PublicKeyToNodeKeyAddress(publicKey)
 	if err != nil {
-		return nil, errExecutionReverted
+		return g.revert("invalid public key")
 	}
 
 	offset = g.state.NodesOffsetByNodeKeyAddress(nodeKeyAddr)
 
 	// Can not register if node key is duplicate.
 	if offset.Cmp(big.NewInt(0)) >= 0 {
-		return nil, errExecutionReverted
+		return g.revert("duplicate node key")
 	}
 
 	offset = g.state.LenNodes()
@@ -1784,17 +1825,17 @@ func (g *GovernanceContract) stake() ([]byte, error) {
 	value := g.contract.Value()
 
 	if big.NewInt(0).Cmp(value) == 0 {
-		return nil, errExecutionReverted
+		return g.revert("no value staked")
 	}
 
 	offset := g.state.NodesOffsetByAddress(caller)
 	if offset.Cmp(big.NewInt(0)) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("caller is not a registered node")
 	}
 
 	node := g.state.Node(offset)
 	if node.Fined.Cmp(big.NewInt(0)) > 0 {
-		return nil, errExecutionReverted
+		return g.revert("node has unpaid fine")
 	}
 
 	node.Staked = new(big.Int).Add(node.Staked, value)
@@ -1811,22 +1852,22 @@ func (g *GovernanceContract) unstake(amount *big.Int) ([]byte, error) {
 
 	offset := g.state.NodesOffsetByAddress(caller)
 	if offset.Cmp(big.NewInt(0)) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("caller is not a registered node")
 	}
 
 	node := g.state.Node(offset)
 
 	// Can not unstake if there are unpaied fine.
 	if node.Fined.Cmp(big.NewInt(0)) > 0 {
-		return nil, errExecutionReverted
+		return g.revert("node has unpaid fine")
 	}
 
 	// Can not unstake if there are unwithdrawn stake.
 	if node.Unstaked.Cmp(big.NewInt(0)) > 0 {
-		return nil, errExecutionReverted
+		return g.revert("node has pending withdrawal")
 	}
 	if node.Staked.Cmp(amount) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("insufficient stake")
 	}
 
 	node.Staked = new(big.Int).Sub(node.Staked, amount)
@@ -1841,14 +1882,14 @@ func (g *GovernanceContract) unstake(amount *big.Int) ([]byte, error) {
 }
 
 func (g *GovernanceContract) withdraw() ([]byte, error) {
-	if !g.withdrawable() {
-		return nil, errExecutionReverted
+	if reason := g.checkWithdrawable(); reason != "" {
+		return g.revert(reason)
 	}
 	caller := g.contract.Caller()
 
 	offset := g.state.NodesOffsetByAddress(caller)
 	if offset.Cmp(big.NewInt(0)) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("caller is not a registered node")
 	}
 
 	node := g.state.Node(offset)
@@ -1875,7 +1916,7 @@ func (g *GovernanceContract) withdraw() ([]byte, error) {
 
 	// Return the staked fund.
 	if !g.transfer(GovernanceContractAddress, node.Owner, amount) {
-		return nil, errExecutionReverted
+		return g.revert("failed to transfer withdrawn stake")
 	}
 	g.state.emitWithdrawn(caller, amount)
 
@@ -1883,38 +1924,50 @@ func (g *GovernanceContract) withdraw() ([]byte, error) {
 }
 
 func (g *GovernanceContract) withdrawable() bool {
+	return g.checkWithdrawable() == ""
+}
+
+// checkWithdrawable returns the reason why the caller can not withdraw, or an
+// empty string if the withdrawal is allowed.
+func (g *GovernanceContract) checkWithdrawable() string {
 	caller := g.contract.Caller()
 
 	offset := g.state.NodesOffsetByAddress(caller)
 	if offset.Cmp(big.NewInt(0)) < 0 {
-		return false
+		return "caller is not a registered node"
 	}
 
 	node := g.state.Node(offset)
 
 	// Can not withdraw if there are unpaied fine.
 	if node.Fined.Cmp(big.NewInt(0)) > 0 {
-		return false
+		return "node has unpaid fine"
 	}
 
 	// Can not withdraw if there are no pending withdrawal.
 	if node.Unstaked.Cmp(big.NewInt(0)) == 0 {
-		return false
+		return "no pending withdrawal"
 	}
 
 	unlockTime := new(big.Int).Add(node.UnstakedAt, g.state.LockupPeriod())
-	return g.evm.Time.Cmp(unlockTime) > 0
+	if g.evm.Time.Cmp(unlockTime) <= 0 {
+		return "lockup period not expired"
+	}
+	return ""
 }
 
 func (g *GovernanceContract) payFine(nodeAddr common.Address) ([]byte, error) {
 	nodeOffset := g.state.NodesOffsetByAddress(nodeAddr)
 	if nodeOffset.Cmp(big.NewInt(0)) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("node not found")
 	}
 
 	node := g.state.Node(nodeOffset)
-	if node.Fined.Cmp(big.NewInt(0)) <= 0 || node.Fined.Cmp(g.contract.Value()) < 0 {
-		return nil, errExecutionReverted
+	if node.Fined.Cmp(big.NewInt(0)) <= 0 {
+		return g.revert("node has no unpaid fine")
+	}
+	if node.Fined.Cmp(g.contract.Value()) < 0 {
+		return g.revert("payment exceeds unpaid fine")
 	}
 
 	node.Fined = new(big.Int).Sub(node.Fined, g.contract.Value())
@@ -1931,7 +1984,7 @@ func (g *GovernanceContract) payFine(nodeAddr common.Address) ([]byte, error) {
 func (g *GovernanceContract) proposeCRS(nextRound *big.Int, signedCRS []byte) ([]byte, error) {
 	if nextRound.Uint64() != g.evm.Round.Uint64()+1 ||
 		g.state.CRSRound().Uint64() == nextRound.Uint64() {
-		return nil, errExecutionReverted
+		return g.revert("invalid CRS round")
 	}
 
 	prevCRS := g.state.CRS()
@@ -1947,14 +2000,14 @@ func (g *GovernanceContract) proposeCRS(nextRound *big.Int, signedCRS []byte) ([
 		NotarySetSize: uint32(g.state.NotarySetSize().Uint64())})
 	dkgGPK, err := g.coreDKGUtils.NewGroupPublicKey(&g.state, nextRound, threshold)
 	if err != nil {
-		return nil, errExecutionReverted
+		return g.revert("failed to recover group public key")
 	}
 	signature := coreCrypto.Signature{
 		Type:      "bls",
 		Signature: signedCRS,
 	}
 	if !dkgGPK.VerifySignature(coreCommon.Hash(prevCRS), signature) {
-		return nil, errExecutionReverted
+		return g.revert("invalid CRS signature")
 	}
 
 	// Save new CRS into state and increase round.
@@ -1992,7 +2045,7 @@ func (g *GovernanceContract) fine(nodeAddr common.Address, amount *big.Int, payl
 
 	nodeOffset := g.state.NodesOffsetByAddress(nodeAddr)
 	if nodeOffset.Cmp(big.NewInt(0)) < 0 {
-		return errExecutionReverted
+		return errors.New("node not found")
 	}
 
 	// Set fined value.
@@ -2013,45 +2066,45 @@ func (g *GovernanceContract) report(reportType *big.Int, arg1, arg2 []byte) ([]b
 	case FineTypeForkVote:
 		vote1 := new(coreTypes.Vote)
 		if err := rlp.DecodeBytes(arg1, vote1); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid vote")
 		}
 		vote2 := new(coreTypes.Vote)
 		if err := rlp.DecodeBytes(arg2, vote2); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid vote")
 		}
 		need, err := coreUtils.NeedPenaltyForkVote(vote1, vote2)
 		if !need || err != nil {
-			return nil, errExecutionReverted
+			return g.revert("votes are not forked")
 		}
 		reportedNodeID = vote1.ProposerID
 	case FineTypeForkBlock:
 		block1 := new(coreTypes.Block)
 		if err := rlp.DecodeBytes(arg1, block1); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid block")
 		}
 		block2 := new(coreTypes.Block)
 		if err := rlp.DecodeBytes(arg2, block2); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid block")
 		}
 		need, err := coreUtils.NeedPenaltyForkBlock(block1, block2)
 		if !need || err != nil {
-			return nil, errExecutionReverted
+			return g.revert("blocks are not forked")
 		}
 		reportedNodeID = block1.ProposerID
 	default:
-		return nil, errExecutionReverted
+		return g.revert("unknown report type")
 	}
 
 	node, err := g.state.GetNodeByID(reportedNodeID)
 	if err != nil {
-		return nil, errExecutionReverted
+		return g.revert("reported node not found")
 	}
 
 	g.state.emitReported(node.Owner, reportType, arg1, arg2)
 
 	fineValue := g.state.FineValue(reportType)
 	if err := g.fine(node.Owner, fineValue, arg1, arg2); err != nil {
-		return nil, errExecutionReverted
+		return g.revert(err.Error())
 	}
 	return nil, nil
 }
@@ -2072,7 +2125,7 @@ func (g *GovernanceContract) resetDKG(newSignedCRS []byte) ([]byte, error) {
 
 	// Just restart DEXON if failed at round 0.
 	if round.Cmp(big.NewInt(0)) == 0 {
-		return nil, errExecutionReverted
+		return g.revert("can not reset DKG at round 0")
 	}
 
 	// Extend the the current round.
@@ -2096,7 +2149,7 @@ func (g *GovernanceContract) resetDKG(newSignedCRS []byte) ([]byte, error) {
 	// Check if current block over 85%of current round.
 	blockHeight := g.evm.Context.BlockNumber
 	if blockHeight.Cmp(targetBlockNum) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("too early to reset DKG")
 	}
 
 	tsigThreshold := coreUtils.GetDKGThreshold(&coreTypes.Config{
@@ -2122,12 +2175,12 @@ func (g *GovernanceContract) resetDKG(newSignedCRS []byte) ([]byte, error) {
 
 			// DKG success.
 			if err == nil {
-				return nil, errExecutionReverted
+				return g.revert("DKG succeeded")
 			}
 			switch err {
 			case dkgTypes.ErrNotReachThreshold, dkgTypes.ErrInvalidThreshold:
 			default:
-				return nil, errExecutionReverted
+				return g.revert("failed to recover group public key")
 			}
 		}
 	}
@@ -2138,7 +2191,7 @@ func (g *GovernanceContract) resetDKG(newSignedCRS []byte) ([]byte, error) {
 	// Update CRS.
 	state, err := getRoundState(g.evm, round)
 	if err != nil {
-		return nil, errExecutionReverted
+		return g.revert("round state not found")
 	}
 	prevCRS := state.CRS()
 
@@ -2157,14 +2210,14 @@ func (g *GovernanceContract) resetDKG(newSignedCRS []byte) ([]byte, error) {
 		coreUtils.GetDKGThreshold(&coreTypes.Config{
 			NotarySetSize: uint32(g.configNotarySetSize(round).Uint64())}))
 	if err != nil {
-		return nil, errExecutionReverted
+		return g.revert("failed to recover group public key")
 	}
 	signature := coreCrypto.Signature{
 		Type:      "bls",
 		Signature: newSignedCRS,
 	}
 	if !dkgGPK.VerifySignature(coreCommon.Hash(prevCRS), signature) {
-		return nil, errExecutionReverted
+		return g.revert("invalid CRS signature")
 	}
 
 	// Clear DKG states for next round.
@@ -2189,7 +2242,7 @@ func (g *GovernanceContract) resetDKG(newSignedCRS []byte) ([]byte, error) {
 // Run executes governance contract.
 func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (ret []byte, err error) {
 	if len(input) < 4 {
-		return nil, errExecutionReverted
+		return g.revert("invalid input")
 	}
 
 	// Initialize contract state.
@@ -2200,7 +2253,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	// Parse input.
 	method, exists := GovernanceABI.Sig2Method[string(input[:4])]
 	if !exists {
-		return nil, errExecutionReverted
+		return g.revert("unknown method")
 	}
 
 	arguments := input[4:]
@@ -2210,31 +2263,31 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "addDKGComplaint":
 		var Complaint []byte
 		if err := method.Inputs.Unpack(&Complaint, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.addDKGComplaint(Complaint)
 	case "addDKGMasterPublicKey":
 		var PublicKey []byte
 		if err := method.Inputs.Unpack(&PublicKey, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.addDKGMasterPublicKey(PublicKey)
 	case "addDKGMPKReady":
 		var MPKReady []byte
 		if err := method.Inputs.Unpack(&MPKReady, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.addDKGMPKReady(MPKReady)
 	case "addDKGFinalize":
 		var Finalize []byte
 		if err := method.Inputs.Unpack(&Finalize, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.addDKGFinalize(Finalize)
 	case "addDKGSuccess":
 		var Success []byte
 		if err := method.Inputs.Unpack(&Success, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.addDKGSuccess(Success)
 	case "nodesLength":
@@ -2246,7 +2299,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "payFine":
 		address := common.Address{}
 		if err := method.Inputs.Unpack(&address, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.payFine(address)
 	case "proposeCRS":
@@ -2255,7 +2308,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			SignedCRS []byte
 		}{}
 		if err := method.Inputs.Unpack(&args, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.proposeCRS(args.Round, args.SignedCRS)
 	case "report":
@@ -2265,7 +2318,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			Arg2 []byte
 		}{}
 		if err := method.Inputs.Unpack(&args, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.report(args.Type, args.Arg1, args.Arg2)
 	case "resetDKG":
@@ -2273,7 +2326,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			NewSignedCRS []byte
 		}{}
 		if err := method.Inputs.Unpack(&args, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.resetDKG(args.NewSignedCRS)
 	case "register":
@@ -2285,7 +2338,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			Url       string
 		}{}
 		if err := method.Inputs.Unpack(&args, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.register(args.PublicKey, args.Name, args.Email, args.Location, args.Url)
 	case "stake":
@@ -2293,13 +2346,13 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "transferOwnership":
 		var newOwner common.Address
 		if err := method.Inputs.Unpack(&newOwner, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.transferOwnership(newOwner)
 	case "transferNodeOwnership":
 		var newOwner common.Address
 		if err := method.Inputs.Unpack(&newOwner, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.transferNodeOwnership(newOwner)
 	case "transferNodeOwnershipByFoundation":
@@ -2308,19 +2361,19 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			NewOwner common.Address
 		}{}
 		if err := method.Inputs.Unpack(&args, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.transferNodeOwnershipByFoundation(args.OldOwner, args.NewOwner)
 	case "unstake":
 		amount := new(big.Int)
 		if err := method.Inputs.Unpack(&amount, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.unstake(amount)
 	case "updateConfiguration":
 		var cfg rawConfigStruct
 		if err := method.Inputs.Unpack(&cfg, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.updateConfiguration(&cfg)
 	case "withdraw":
@@ -2357,7 +2410,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "dkgComplaints":
 		offset := new(big.Int)
 		if err := method.Inputs.Unpack(&offset, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		complaint := g.state.DKGComplaint(offset)
 		res, err := method.Outputs.Pack(complaint)
@@ -2368,7 +2421,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "dkgComplaintsProposed":
 		id := Bytes32{}
 		if err := method.Inputs.Unpack(&id, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		proposed := g.state.DKGComplaintProposed(id)
 		res, err := method.Outputs.Pack(proposed)
@@ -2379,7 +2432,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "dkgFinalizeds":
 		addr := common.Address{}
 		if err := method.Inputs.Unpack(&addr, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		finalized := g.state.DKGFinalized(addr)
 		res, err := method.Outputs.Pack(finalized)
@@ -2397,7 +2450,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "dkgSuccesses":
 		addr := common.Address{}
 		if err := method.Inputs.Unpack(&addr, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		finalized := g.state.DKGSuccess(addr)
 		res, err := method.Outputs.Pack(finalized)
@@ -2415,7 +2468,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "dkgMasterPublicKeys":
 		offset := new(big.Int)
 		if err := method.Inputs.Unpack(&offset, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		mpk := g.state.DKGMasterPublicKey(offset)
 		res, err := method.Outputs.Pack(mpk)
@@ -2426,7 +2479,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "dkgMasterPublicKeyOffset":
 		id := Bytes32{}
 		if err := method.Inputs.Unpack(&id, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		offset := g.state.DKGMasterPublicKeyOffset(id)
 		res, err := method.Outputs.Pack(offset)
@@ -2437,7 +2490,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "dkgMPKReadys":
 		addr := common.Address{}
 		if err := method.Inputs.Unpack(&addr, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		ready := g.state.DKGMPKReady(addr)
 		res, err := method.Outputs.Pack(ready)
@@ -2455,7 +2508,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "dkgResetCount":
 		round := new(big.Int)
 		if err := method.Inputs.Unpack(&round, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		res, err := method.Outputs.Pack(g.state.DKGResetCount(round))
 		if err != nil {
@@ -2471,7 +2524,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "finedRecords":
 		record := Bytes32{}
 		if err := method.Inputs.Unpack(&record, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		value := g.state.FineRecords(record)
 		res, err := method.Outputs.Pack(value)
@@ -2482,7 +2535,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "fineValues":
 		index := new(big.Int)
 		if err := method.Inputs.Unpack(&index, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		value := g.state.FineValue(index)
 		res, err := method.Outputs.Pack(value)
@@ -2511,7 +2564,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "lastProposedHeight":
 		address := common.Address{}
 		if err := method.Inputs.Unpack(&address, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		res, err := method.Outputs.Pack(g.state.LastProposedHeight(address))
 		if err != nil {
@@ -2557,7 +2610,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "nodes":
 		index := new(big.Int)
 		if err := method.Inputs.Unpack(&index, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		info := g.state.Node(index)
 		res, err := method.Outputs.Pack(
@@ -2571,7 +2624,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "nodesOffsetByAddress":
 		address := common.Address{}
 		if err := method.Inputs.Unpack(&address, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		res, err := method.Outputs.Pack(g.state.NodesOffsetByAddress(address))
 		if err != nil {
@@ -2581,7 +2634,7 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "nodesOffsetByNodeKeyAddress":
 		address := common.Address{}
 		if err := method.Inputs.Unpack(&address, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		res, err := method.Outputs.Pack(g.state.NodesOffsetByNodeKeyAddress(address))
 		if err != nil {
@@ -2615,13 +2668,13 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	case "replaceNodePublicKey":
 		var pk []byte
 		if err := method.Inputs.Unpack(&pk, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		return g.replaceNodePublicKey(pk)
 	case "roundHeight":
 		round := new(big.Int)
 		if err := method.Inputs.Unpack(&round, arguments); err != nil {
-			return nil, errExecutionReverted
+			return g.revert("invalid arguments")
 		}
 		res, err := method.Outputs.Pack(g.state.RoundHeight(round))
 		if err != nil {
@@ -2653,10 +2706,10 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 func (g *GovernanceContract) transferOwnership(newOwner common.Address) ([]byte, error) {
 	// Only owner can update configuration.
 	if g.contract.Caller() != g.state.Owner() {
-		return nil, errExecutionReverted
+		return g.revert("caller is not the owner")
 	}
 	if newOwner == (common.Address{}) {
-		return nil, errExecutionReverted
+		return g.revert("invalid new owner")
 	}
 	g.state.SetOwner(newOwner)
 	return nil, nil
@@ -2664,18 +2717,18 @@ func (g *GovernanceContract) transferOwnership(newOwner common.Address) ([]byte,
 
 func (g *GovernanceContract) transferNodeOwnership(newOwner common.Address) ([]byte, error) {
 	if newOwner == (common.Address{}) {
-		return nil, errExecutionReverted
+		return g.revert("invalid new owner")
 	}
 	caller := g.contract.Caller()
 
 	offset := g.state.NodesOffsetByAddress(caller)
 	if offset.Cmp(big.NewInt(0)) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("caller is not a registered node")
 	}
 
 	newOffset := g.state.NodesOffsetByAddress(newOwner)
 	if newOffset.Cmp(big.NewInt(0)) >= 0 {
-		return nil, errExecutionReverted
+		return g.revert("new owner already registered")
 	}
 
 	node := g.state.Node(offset)
@@ -2693,21 +2746,21 @@ func (g *GovernanceContract) transferNodeOwnership(newOwner common.Address) ([]b
 func (g *GovernanceContract) transferNodeOwnershipByFoundation(oldOwner, newOwner common.Address) ([]byte, error) {
 	// Only owner can update configuration.
 	if g.contract.Caller() != g.state.Owner() {
-		return nil, errExecutionReverted
+		return g.revert("caller is not the owner")
 	}
 
 	if newOwner == (common.Address{}) {
-		return nil, errExecutionReverted
+		return g.revert("invalid new owner")
 	}
 
 	offset := g.state.NodesOffsetByAddress(oldOwner)
 	if offset.Cmp(big.NewInt(0)) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("node not found")
 	}
 
 	newOffset := g.state.NodesOffsetByAddress(newOwner)
 	if newOffset.Cmp(big.NewInt(0)) >= 0 {
-		return nil, errExecutionReverted
+		return g.revert("new owner already registered")
 	}
 
 	node := g.state.Node(offset)
@@ -2727,14 +2780,14 @@ func (g *GovernanceContract) replaceNodePublicKey(newPublicKey []byte) ([]byte,
 
 	offset := g.state.NodesOffsetByAddress(caller)
 	if offset.Cmp(big.NewInt(0)) < 0 {
-		return nil, errExecutionReverted
+		return g.revert("caller is not a registered node")
 	}
 
 	node := g.state.Node(offset)
 
-	_, err := publicKeyToNodeKeyAddress(newPublicKey)
+	_, err := PublicKeyToNodeKeyAddress(newPublicKey)
 	if err != nil {
-		return nil, errExecutionReverted
+		return g.revert("invalid public key")
 	}
 
 	g.state.DeleteNodeOffsets(node)
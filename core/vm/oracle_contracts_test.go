@@ -34,6 +34,7 @@ import (
 	dkgTypes "github.com/dexon-foundation/dexon-consensus/core/types/dkg"
 	coreUtils "github.com/dexon-foundation/dexon-consensus/core/utils"
 
+	"github.com/dexon-foundation/dexon/accounts/abi"
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/core/state"
 	"github.com/dexon-foundation/dexon/crypto"
@@ -236,7 +237,7 @@ func (g *OracleContractsTestSuite) SetupTest() {
 }
 
 func (g *OracleContractsTestSuite) TearDownTest() {
-	OracleContracts[GovernanceContractAddress] = func() OracleContract {
+	OracleContracts[GovernanceContractAddress].New = func() OracleContract {
 		return &GovernanceContract{
 			coreDKGUtils: &defaultCoreDKGUtils{},
 		}
@@ -275,6 +276,115 @@ func (g *OracleContractsTestSuite) TestTransferOwnership() {
 	g.Require().Equal(addr, g.s.Owner())
 }
 
+func (g *OracleContractsTestSuite) TestOracleTracing() {
+	logger := NewStructLogger(nil)
+	vmConfig := Config{IsBlockProposer: true, Debug: true, Tracer: logger}
+
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+
+	evm := NewEVM(g.context, g.stateDB, params.TestChainConfig, vmConfig)
+	_, gas, err := evm.Call(AccountRef(addr), GovernanceContractAddress, input, 10000000, amount)
+	g.Require().NoError(err)
+
+	frames := logger.OracleFrames()
+	g.Require().Len(frames, 1)
+	frame := frames[0]
+	g.Require().Equal(GovernanceContractAddress, frame.Address)
+	g.Require().Equal(addr, frame.Caller)
+	g.Require().Equal("register", frame.Method)
+	g.Require().Equal("Test1", frame.Args["Name"])
+	g.Require().Equal(uint64(10000000)-gas, frame.GasUsed)
+	g.Require().NotEmpty(frame.Storage[GovernanceContractAddress])
+	g.Require().Len(frame.Logs, 2)
+	g.Require().Empty(frame.Error)
+
+	// Registering twice reverts.
+	evm = NewEVM(g.context, g.stateDB, params.TestChainConfig, vmConfig)
+	_, _, err = evm.Call(AccountRef(addr), GovernanceContractAddress, input, 10000000, amount)
+	g.Require().Error(err)
+
+	frames = logger.OracleFrames()
+	g.Require().Len(frames, 2)
+	g.Require().Equal(errExecutionReverted.Error(), frames[1].Error)
+	g.Require().Equal("caller already registered", frames[1].RevertReason)
+
+	// Tracing does not leave the recorder in place.
+	g.Require().Equal(g.stateDB, evm.StateDB)
+}
+
+func (g *OracleContractsTestSuite) TestRevertReason() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	checkReason := func(ret []byte, err error, expected string) {
+		g.Require().Equal(errExecutionReverted, err)
+		reason, err := abi.UnpackRevert(ret)
+		g.Require().NoError(err)
+		g.Require().Equal(expected, reason)
+	}
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	ret, err := g.call(GovernanceContractAddress, addr, input, amount)
+	checkReason(ret, err, "caller already registered")
+
+	_, addrDup := newPrefundAccount(g.stateDB)
+	ret, err = g.call(GovernanceContractAddress, addrDup, input, amount)
+	checkReason(ret, err, "duplicate node key")
+
+	input, err = GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+	ret, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	checkReason(ret, err, "no pending withdrawal")
+
+	input, err = GovernanceABI.ABI.Pack("unstake", amount)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+	ret, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	checkReason(ret, err, "lockup period not expired")
+
+	ret, err = g.call(GovernanceContractAddress, addr, []byte{1, 2, 3, 4}, big.NewInt(0))
+	checkReason(ret, err, "unknown method")
+}
+
+func (g *OracleContractsTestSuite) TestRevertReasonFork() {
+	_, addr := newPrefundAccount(g.stateDB)
+	input, err := GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+
+	config := *params.TestChainConfig
+	config.RevertReasonBlock = big.NewInt(5)
+	call := func(number int64) ([]byte, error) {
+		g.context.BlockNumber = big.NewInt(number)
+		evm := NewEVM(g.context, g.stateDB, &config, Config{IsBlockProposer: true})
+		ret, _, err := evm.Call(AccountRef(addr), GovernanceContractAddress, input, 10000000, big.NewInt(0))
+		return ret, err
+	}
+	// Before the fork the revert carries no return data.
+	ret, err := call(4)
+	g.Require().Equal(errExecutionReverted, err)
+	g.Require().Empty(ret)
+
+	ret, err = call(5)
+	g.Require().Equal(errExecutionReverted, err)
+	reason, err := abi.UnpackRevert(ret)
+	g.Require().NoError(err)
+	g.Require().Equal("caller is not a registered node", reason)
+}
+
 func (g *OracleContractsTestSuite) TestTransferNodeOwnership() {
 	privKey, addr := newPrefundAccount(g.stateDB)
 	pk := crypto.FromECDSAPub(&privKey.PublicKey)
@@ -959,7 +1069,7 @@ func (g *OracleContractsTestSuite) TestMiscVariableReading() {
 	g.Require().NoError(err)
 	g.Require().Equal(0, int(value.Uint64()))
 
-	addr, err = publicKeyToNodeKeyAddress(pk)
+	addr, err = PublicKeyToNodeKeyAddress(pk)
 	g.Require().NoError(err)
 	input, err = GovernanceABI.ABI.Pack("nodesOffsetByNodeKeyAddress", addr)
 	g.Require().NoError(err)
@@ -1127,7 +1237,7 @@ func (g *OracleContractsTestSuite) TestResetDKG() {
 	mock := &testCoreMock{
 		tsigReturn: true,
 	}
-	OracleContracts[GovernanceContractAddress] = func() OracleContract {
+	OracleContracts[GovernanceContractAddress].New = func() OracleContract {
 		return &GovernanceContract{
 			coreDKGUtils: mock,
 		}
@@ -0,0 +1,181 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"math/big"
+
+	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
+	dkgTypes "github.com/dexon-foundation/dexon-consensus/core/types/dkg"
+	coreUtils "github.com/dexon-foundation/dexon-consensus/core/utils"
+
+	"github.com/dexon-foundation/dexon/accounts/abi"
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/params"
+)
+
+var RandomnessBeaconAddress = common.HexToAddress("5f1c0a1b3e9d2c8c54e7d8e0d4e7f5a1b0a9cbe2")
+
+var RandomnessBeaconABI *OracleContractABI
+
+// RandomnessBeaconWindow is the number of recent blocks, excluding the
+// current one, whose randomness is available from the randomness beacon.
+const RandomnessBeaconWindow = 256
+
+func init() {
+	RandomnessBeaconABI = NewOracleContractABI(RandomnessBeaconABIJSON)
+
+	RegisterOracleContract(&OracleContractSpec{
+		Address: RandomnessBeaconAddress,
+		ABI:     RandomnessBeaconABI,
+		Fork: func(config *params.ChainConfig) *big.Int {
+			return config.RandomnessBeaconBlock
+		},
+		GasTable: map[string]uint64{
+			"randomness":     params.RandomnessBeaconGas,
+			"crs":            params.RandomnessBeaconGas,
+			"groupPublicKey": params.RandomnessBeaconGroupPublicKeyGas,
+		},
+		New: func() OracleContract {
+			return &RandomnessBeaconContract{}
+		},
+	})
+}
+
+// RandomnessBeaconContract exposes the finalized block randomness and the
+// round CRS and DKG group public key, so that contracts can share and verify
+// the same random values across transactions.
+type RandomnessBeaconContract struct {
+	evm *EVM
+}
+
+func (r *RandomnessBeaconContract) revert(reason string) ([]byte, error) {
+	return abi.PackRevert(reason), errExecutionReverted
+}
+
+func (r *RandomnessBeaconContract) pack(method string, value interface{}) ([]byte, error) {
+	res, err := RandomnessBeaconABI.ABI.Methods[method].Outputs.Pack(value)
+	if err != nil {
+		return nil, errExecutionReverted
+	}
+	return res, nil
+}
+
+// randomness returns the randomness of the block at the given height.
+func (r *RandomnessBeaconContract) randomness(height *big.Int) ([]byte, error) {
+	current := r.evm.BlockNumber
+	if height.Cmp(current) > 0 {
+		return r.revert("block not finalized")
+	}
+	if height.Cmp(current) == 0 {
+		return r.pack("randomness", r.evm.Randomness)
+	}
+	if new(big.Int).Sub(current, height).Uint64() > RandomnessBeaconWindow {
+		return r.revert("block too old")
+	}
+	if r.evm.GetRandomness == nil {
+		return r.revert("randomness not available")
+	}
+	randomness, err := r.evm.GetRandomness(height.Uint64())
+	if err != nil {
+		return r.revert("randomness not available")
+	}
+	return r.pack("randomness", randomness)
+}
+
+// roundState returns the governance state at the beginning of a past or the
+// current round.
+func (r *RandomnessBeaconContract) roundState(round *big.Int) (*GovernanceState, string) {
+	if round.Cmp(r.evm.Round) > 0 {
+		return nil, "round not started"
+	}
+	state, err := getRoundState(r.evm, round)
+	if err != nil {
+		return nil, "round state not found"
+	}
+	return state, ""
+}
+
+// crs returns the CRS of the given round.
+func (r *RandomnessBeaconContract) crs(round *big.Int) ([]byte, error) {
+	state, reason := r.roundState(round)
+	if reason != "" {
+		return r.revert(reason)
+	}
+	if state.CRSRound().Cmp(round) != 0 {
+		return r.revert("CRS not found")
+	}
+	return r.pack("crs", state.CRS())
+}
+
+// groupPublicKey returns the DKG group public key of the given round.
+func (r *RandomnessBeaconContract) groupPublicKey(round *big.Int) ([]byte, error) {
+	state, reason := r.roundState(round)
+	if reason != "" {
+		return r.revert(reason)
+	}
+	if state.DKGRound().Cmp(round) != 0 {
+		return r.revert("DKG not found")
+	}
+	configState, err := getConfigState(r.evm, round)
+	if err != nil {
+		return r.revert("round state not found")
+	}
+	threshold := coreUtils.GetDKGThreshold(&coreTypes.Config{
+		NotarySetSize: uint32(configState.NotarySetSize().Uint64())})
+	gpk, err := dkgTypes.NewGroupPublicKey(round.Uint64(),
+		state.DKGMasterPublicKeyItems(), state.DKGComplaintItems(), threshold)
+	if err != nil {
+		return r.revert("failed to recover group public key")
+	}
+	return r.pack("groupPublicKey", gpk.GroupPublicKey.Bytes())
+}
+
+// Run executes the randomness beacon contract.
+func (r *RandomnessBeaconContract) Run(evm *EVM, input []byte, contract *Contract) (ret []byte, err error) {
+	if len(input) < 4 {
+		return r.revert("invalid input")
+	}
+	r.evm = evm
+
+	// The contract has no way to pay out, so reject value instead of locking it.
+	if contract.Value().Sign() > 0 {
+		return r.revert("value not accepted")
+	}
+
+	method, exists := RandomnessBeaconABI.Sig2Method[string(input[:4])]
+	if !exists {
+		return r.revert("unknown method")
+	}
+	arguments := input[4:]
+
+	var arg *big.Int
+	if err := method.Inputs.Unpack(&arg, arguments); err != nil {
+		return r.revert("invalid arguments")
+	}
+
+	switch method.Name {
+	case "randomness":
+		return r.randomness(arg)
+	case "crs":
+		return r.crs(arg)
+	case "groupPublicKey":
+		return r.groupPublicKey(arg)
+	}
+	return r.revert("unknown method")
+}
@@ -0,0 +1,133 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/accounts/abi"
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/params"
+)
+
+func TestRandomnessBeacon(t *testing.T) {
+	config := *params.TestChainConfig
+	config.RandomnessBeaconBlock = big.NewInt(5)
+	h := newOracleTestHarness(t, &config)
+	spec := OracleContracts[RandomnessBeaconAddress]
+	caller := common.HexToAddress("0x1234")
+
+	h.context.GetRandomness = func(n uint64) ([]byte, error) {
+		return []byte{byte(n)}, nil
+	}
+	h.context.Randomness = []byte{0xff}
+
+	unpackBytes := func(ret []byte) []byte {
+		var value []byte
+		if err := RandomnessBeaconABI.ABI.Unpack(&value, "randomness", ret); err != nil {
+			t.Fatalf("failed to unpack: %v", err)
+		}
+		return value
+	}
+	expectRevert := func(method string, arg int64, reason string) {
+		ret, _, err := h.call(spec, caller, big.NewInt(0), method, big.NewInt(arg))
+		if err != errExecutionReverted {
+			t.Fatalf("%s(%d): expected revert, got %v", method, arg, err)
+		}
+		if r, _ := abi.UnpackRevert(ret); r != reason {
+			t.Errorf("%s(%d): expected reason %q, got %q", method, arg, reason, r)
+		}
+	}
+
+	// Not activated before the fork.
+	h.setBlock(4)
+	ret, _, err := h.call(spec, caller, big.NewInt(0), "randomness", big.NewInt(3))
+	if err != nil || len(ret) != 0 {
+		t.Errorf("expected empty call before fork, got %x, %v", ret, err)
+	}
+
+	h.setBlock(300)
+	ret, gasUsed, err := h.call(spec, caller, big.NewInt(0), "randomness", big.NewInt(300))
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	if !bytes.Equal(unpackBytes(ret), []byte{0xff}) {
+		t.Errorf("expected current randomness, got %x", ret)
+	}
+	if gasUsed != params.RandomnessBeaconGas {
+		t.Errorf("unexpected gas used: %d", gasUsed)
+	}
+	ret, _, err = h.call(spec, caller, big.NewInt(0), "randomness", big.NewInt(44))
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	if !bytes.Equal(unpackBytes(ret), []byte{44}) {
+		t.Errorf("expected randomness of block 44, got %x", ret)
+	}
+	expectRevert("randomness", 43, "block too old")
+
+	// Value sent along is rejected and stays with the caller.
+	h.stateDB.AddBalance(caller, big.NewInt(100))
+	ret, _, err = h.call(spec, caller, big.NewInt(100), "randomness", big.NewInt(300))
+	if err != errExecutionReverted {
+		t.Fatalf("expected revert of call with value, got %v", err)
+	}
+	if r, _ := abi.UnpackRevert(ret); r != "value not accepted" {
+		t.Errorf("expected reason %q, got %q", "value not accepted", r)
+	}
+	if balance := h.stateDB.GetBalance(caller); balance.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("caller balance mismatch: have %v, want 100", balance)
+	}
+	if balance := h.stateDB.GetBalance(RandomnessBeaconAddress); balance.Sign() != 0 {
+		t.Errorf("contract balance mismatch: have %v, want 0", balance)
+	}
+
+	// Randomness of blocks the chain can't provide.
+	h.context.GetRandomness = func(n uint64) ([]byte, error) {
+		return nil, errors.New("no chain")
+	}
+	expectRevert("randomness", 44, "randomness not available")
+	expectRevert("randomness", 301, "block not finalized")
+
+	// CRS of the current round.
+	crs := common.HexToHash("0x1111")
+	gs := &GovernanceState{h.stateDB}
+	gs.SetCRS(crs)
+	ret, _, err = h.call(spec, caller, big.NewInt(0), "crs", big.NewInt(0))
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	if common.BytesToHash(ret) != crs {
+		t.Errorf("expected crs %x, got %x", crs, ret)
+	}
+	expectRevert("crs", 1, "round not started")
+
+	// No DKG happened in the round.
+	expectRevert("groupPublicKey", 0, "failed to recover group public key")
+	expectRevert("groupPublicKey", 1, "round not started")
+
+	// No chain behind the EVM.
+	h.context.GetRandomness = nil
+	h.context.StateAtNumber = nil
+	expectRevert("randomness", 44, "randomness not available")
+	expectRevert("crs", 0, "round state not found")
+	expectRevert("groupPublicKey", 0, "round state not found")
+}
@@ -0,0 +1,186 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core/state"
+	"github.com/dexon-foundation/dexon/ethdb"
+	"github.com/dexon-foundation/dexon/params"
+)
+
+// oracleTestHarness is a minimal chain environment for testing oracle
+// contracts without a blockchain.
+type oracleTestHarness struct {
+	t       *testing.T
+	config  *params.ChainConfig
+	stateDB *state.StateDB
+	context Context
+}
+
+func newOracleTestHarness(t *testing.T, config *params.ChainConfig) *oracleTestHarness {
+	stateDB, err := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	if err != nil {
+		t.Fatalf("failed to create state: %v", err)
+	}
+	InitOracleContracts(config, stateDB)
+
+	h := &oracleTestHarness{t: t, config: config, stateDB: stateDB}
+	h.context = Context{
+		CanTransfer: func(db StateDB, addr common.Address, amount *big.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db StateDB, sender common.Address, recipient common.Address, amount *big.Int) {
+			db.SubBalance(sender, amount)
+			db.AddBalance(recipient, amount)
+		},
+		GetRoundHeight: func(uint64) (uint64, bool) {
+			return 0, false
+		},
+		StateAtNumber: func(uint64) (*state.StateDB, error) {
+			return h.stateDB, nil
+		},
+		BlockNumber: big.NewInt(0),
+		Time:        big.NewInt(0),
+		Round:       big.NewInt(0),
+	}
+	return h
+}
+
+// register registers the oracle contract and returns a function removing it
+// from the registry.
+func (h *oracleTestHarness) register(spec *OracleContractSpec) func() {
+	RegisterOracleContract(spec)
+	return func() {
+		delete(OracleContracts, spec.Address)
+	}
+}
+
+// setBlock moves the harness to the given block, activating the oracle
+// contracts forked at it.
+func (h *oracleTestHarness) setBlock(number uint64) {
+	h.context.BlockNumber = new(big.Int).SetUint64(number)
+	ActivateOracleContracts(h.config, h.context.BlockNumber, h.stateDB)
+}
+
+// call invokes the method of the oracle contract and returns the output and
+// the gas used.
+func (h *oracleTestHarness) call(spec *OracleContractSpec, caller common.Address,
+	value *big.Int, method string, args ...interface{}) ([]byte, uint64, error) {
+	input, err := spec.ABI.ABI.Pack(method, args...)
+	if err != nil {
+		h.t.Fatalf("failed to pack %s: %v", method, err)
+	}
+	const gas = 10000000
+	evm := NewEVM(h.context, h.stateDB, h.config, Config{})
+	ret, leftOver, err := evm.Call(AccountRef(caller), spec.Address, input, gas, value)
+	return ret, gas - leftOver, err
+}
+
+const echoOracleABIJSON = `
+[
+	{
+		"constant": true,
+		"inputs": [{"name": "Value", "type": "uint256"}],
+		"name": "echo",
+		"outputs": [{"name": "", "type": "uint256"}],
+		"payable": false,
+		"stateMutability": "view",
+		"type": "function"
+	}
+]`
+
+// echoOracle returns its argument.
+type echoOracle struct{}
+
+func (echoOracle) Run(evm *EVM, input []byte, contract *Contract) ([]byte, error) {
+	abi := NewOracleContractABI(echoOracleABIJSON)
+	method, exists := abi.Sig2Method[string(input[:4])]
+	if !exists {
+		return nil, errExecutionReverted
+	}
+	var value *big.Int
+	if err := method.Inputs.Unpack(&value, input[4:]); err != nil {
+		return nil, errExecutionReverted
+	}
+	return method.Outputs.Pack(value)
+}
+
+func TestOracleContractRegistry(t *testing.T) {
+	config := *params.TestChainConfig
+	h := newOracleTestHarness(t, &config)
+
+	spec := &OracleContractSpec{
+		Address: common.HexToAddress("0x00000000000000000000000000000000000000ec"),
+		ABI:     NewOracleContractABI(echoOracleABIJSON),
+		Fork: func(*params.ChainConfig) *big.Int {
+			return big.NewInt(10)
+		},
+		GasTable: map[string]uint64{"echo": 1000},
+		New:      func() OracleContract { return echoOracle{} },
+	}
+	defer h.register(spec)()
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected duplicated registration to panic")
+			}
+		}()
+		RegisterOracleContract(spec)
+	}()
+
+	caller := common.HexToAddress("0x1234")
+
+	// Not activated yet.
+	h.setBlock(9)
+	if spec.IsActive(h.config, h.context.BlockNumber) {
+		t.Error("expected contract to be inactive before its fork")
+	}
+	ret, _, err := h.call(spec, caller, big.NewInt(0), "echo", big.NewInt(42))
+	if err != nil || len(ret) != 0 {
+		t.Errorf("expected empty call before fork, got %x, %v", ret, err)
+	}
+	if h.stateDB.GetCodeSize(spec.Address) != 0 {
+		t.Error("expected no code before fork")
+	}
+
+	// Activated at the fork block.
+	h.setBlock(10)
+	if h.stateDB.GetCodeSize(spec.Address) == 0 {
+		t.Error("expected code to be installed at fork")
+	}
+	ret, gasUsed, err := h.call(spec, caller, big.NewInt(0), "echo", big.NewInt(42))
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	if new(big.Int).SetBytes(ret).Cmp(big.NewInt(42)) != 0 {
+		t.Errorf("unexpected output: %x", ret)
+	}
+	if gasUsed != 1000 {
+		t.Errorf("expected gas table to be charged, used %d", gasUsed)
+	}
+
+	// Contracts without fork are always active.
+	if !OracleContracts[GovernanceContractAddress].IsActive(nil, big.NewInt(0)) {
+		t.Error("expected governance contract to be active")
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/dexon-foundation/dexon/accounts/abi"
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/core/types"
+)
+
+// OracleTracer is implemented by tracers which want to observe the execution
+// of oracle contracts. Oracle contracts are written in Go and never reach
+// CaptureState, so a single frame is reported for each invocation instead.
+type OracleTracer interface {
+	CaptureOracle(env *EVM, frame *OracleFrame) error
+}
+
+// OracleFrame describes a single invocation of an oracle contract.
+type OracleFrame struct {
+	Address      common.Address             `json:"address"`
+	Caller       common.Address             `json:"caller"`
+	Depth        int                        `json:"depth"`
+	Value        *hexutil.Big               `json:"value"`
+	Method       string                     `json:"method"`
+	Args         map[string]interface{}     `json:"args"`
+	Storage      map[common.Address]Storage `json:"storage"`
+	Logs         []*types.Log               `json:"logs"`
+	GasUsed      uint64                     `json:"gasUsed"`
+	Output       hexutil.Bytes              `json:"output"`
+	Error        string                     `json:"error,omitempty"`
+	RevertReason string                     `json:"revertReason,omitempty"`
+}
+
+// oracleStateRecorder wraps a StateDB and records the storage writes and
+// logs emitted by an oracle contract.
+type oracleStateRecorder struct {
+	StateDB
+	storage map[common.Address]Storage
+	logs    []*types.Log
+}
+
+func (r *oracleStateRecorder) SetState(addr common.Address, key, value common.Hash) {
+	if r.storage[addr] == nil {
+		r.storage[addr] = make(Storage)
+	}
+	r.storage[addr][key] = value
+	r.StateDB.SetState(addr, key, value)
+}
+
+func (r *oracleStateRecorder) AddLog(log *types.Log) {
+	r.logs = append(r.logs, log)
+	r.StateDB.AddLog(log)
+}
+
+// runTracedOracleContract runs the oracle contract and reports the resulting
+// frame to the tracer.
+func runTracedOracleContract(tracer OracleTracer, spec *OracleContractSpec,
+	oracle OracleContract, evm *EVM, input []byte, contract *Contract) ([]byte, error) {
+	recorder := &oracleStateRecorder{
+		StateDB: evm.StateDB,
+		storage: make(map[common.Address]Storage),
+	}
+	evm.StateDB = recorder
+	gas := contract.Gas
+
+	ret, err := oracle.Run(evm, input, contract)
+
+	evm.StateDB = recorder.StateDB
+
+	frame := &OracleFrame{
+		Address: contract.Address(),
+		Caller:  contract.Caller(),
+		Depth:   evm.depth,
+		Value:   (*hexutil.Big)(new(big.Int).Set(contract.Value())),
+		Storage: recorder.storage,
+		Logs:    recorder.logs,
+		GasUsed: gas - contract.Gas,
+		Output:  common.CopyBytes(ret),
+	}
+	if spec.ABI != nil && len(input) >= 4 {
+		if method, exists := spec.ABI.Sig2Method[string(input[:4])]; exists {
+			frame.Method = method.Name
+			frame.Args = unpackOracleArgs(method.Inputs, input[4:])
+		}
+	}
+	if err != nil {
+		frame.Error = err.Error()
+		if err == errExecutionReverted {
+			frame.RevertReason, _ = abi.UnpackRevert(ret)
+		}
+	}
+	tracer.CaptureOracle(evm, frame)
+	return ret, err
+}
+
+// unpackOracleArgs decodes the method arguments into a map keyed by the
+// argument names. Undecodable input yields a nil map.
+func unpackOracleArgs(inputs abi.Arguments, data []byte) map[string]interface{} {
+	values, err := inputs.UnpackValues(data)
+	if err != nil {
+		return nil
+	}
+	args := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		name := inputs[i].Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		if b, ok := value.([]byte); ok {
+			value = hexutil.Bytes(b)
+		}
+		args[name] = value
+	}
+	return args
+}
@@ -38,6 +38,8 @@ type Config struct {
 	Coinbase    common.Address
 	BlockNumber *big.Int
 	Time        *big.Int
+	Randomness  []byte
+	Round       *big.Int
 	GasLimit    uint64
 	GasPrice    *big.Int
 	Value       *big.Int
@@ -80,6 +82,9 @@ func setDefaults(cfg *Config) {
 	if cfg.BlockNumber == nil {
 		cfg.BlockNumber = new(big.Int)
 	}
+	if cfg.Round == nil {
+		cfg.Round = new(big.Int)
+	}
 	if cfg.GetHashFn == nil {
 		cfg.GetHashFn = func(n uint64) common.Hash {
 			return common.BytesToHash(crypto.Keccak256([]byte(new(big.Int).SetUint64(n).String())))
@@ -17,6 +17,7 @@
 package runtime
 
 import (
+	"bytes"
 	"math/big"
 	"strings"
 	"testing"
@@ -55,6 +56,9 @@ func TestDefaults(t *testing.T) {
 	if cfg.BlockNumber == nil {
 		t.Error("expected block number to be non nil")
 	}
+	if cfg.Round == nil {
+		t.Error("expected round to be non nil")
+	}
 }
 
 func TestEVM(t *testing.T) {
@@ -94,6 +98,38 @@ func TestExecute(t *testing.T) {
 	}
 }
 
+func TestExecuteRand(t *testing.T) {
+	code := []byte{
+		byte(vm.RAND),
+		byte(vm.PUSH1), 0,
+		byte(vm.MSTORE),
+		byte(vm.RAND),
+		byte(vm.PUSH1), 32,
+		byte(vm.MSTORE),
+		byte(vm.PUSH1), 64,
+		byte(vm.PUSH1), 0,
+		byte(vm.RETURN),
+	}
+	run := func(randomness []byte) []byte {
+		ret, _, err := Execute(code, nil, &Config{Randomness: randomness})
+		if err != nil {
+			t.Fatal("didn't expect error", err)
+		}
+		return ret
+	}
+
+	ret := run([]byte{1, 2, 3})
+	if bytes.Equal(ret[:32], ret[32:]) {
+		t.Error("expected consecutive RAND calls to differ")
+	}
+	if !bytes.Equal(ret, run([]byte{1, 2, 3})) {
+		t.Error("expected RAND to be reproducible with the same randomness")
+	}
+	if bytes.Equal(ret, run([]byte{4, 5, 6})) {
+		t.Error("expected RAND to depend on the randomness")
+	}
+}
+
 func TestCall(t *testing.T) {
 	state, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
 	address := common.HexToAddress("0x0a")
@@ -4448,6 +4448,12 @@ var _bundleJs = []byte((((((((((`!function(modules) {
             title: "System",
             icon: "tachometer"
         }
+    }, {
+        id: "consensus",
+        menu: {
+            title: "Consensus",
+            icon: "handshake-o"
+        }
     }, {
         id: "logs",
         menu: {
@@ -27894,6 +27900,14 @@ var _bundleJs = []byte((((((((((`!function(modules) {
                 diskRead: [],
                 diskWrite: []
             },
+            consensus: {
+                agreementLatency: [],
+                votesPerPosition: [],
+                agreementResults: [],
+                finalizationLag: [],
+                dkg: [],
+                peers: []
+            },
             logs: {
                 chunks: [],
                 endTop: !1,
@@ -27921,6 +27935,14 @@ var _bundleJs = []byte((((((((((`!function(modules) {
             diskRead: appender(200),
             diskWrite: appender(200)
         },
+        consensus: {
+            agreementLatency: appender(200),
+            votesPerPosition: appender(200),
+            agreementResults: appender(200),
+            finalizationLag: appender(200),
+            dkg: replacer,
+            peers: replacer
+        },
         logs: (0, _Logs.inserter)(5)
     }, styles = {
         dashboard: {
@@ -32419,7 +32441,7 @@ var _bundleJs = []byte((((((((((`!function(modules) {
             return protoProps && defineProperties(Constructor.prototype, protoProps), staticProps && defineProperties(Constructor, staticProps), 
             Constructor;
         };
-    }(), _react = __webpack_require__(0), _react2 = _interopRequireDefault(_react), _withStyles = __webpack_require__(10), _withStyles2 = _interopRequireDefault(_withStyles), _common = __webpack_require__(81), _Logs = __webpack_require__(261), _Logs2 = _interopRequireDefault(_Logs), _Footer = __webpack_require__(551), _Footer2 = _interopRequireDefault(_Footer), styles = {
+    }(), _react = __webpack_require__(0), _react2 = _interopRequireDefault(_react), _withStyles = __webpack_require__(10), _withStyles2 = _interopRequireDefault(_withStyles), _common = __webpack_require__(81), _Logs = __webpack_require__(261), _Logs2 = _interopRequireDefault(_Logs), _Consensus = __webpack_require__(949), _Consensus2 = _interopRequireDefault(_Consensus), _Footer = __webpack_require__(551), _Footer2 = _interopRequireDefault(_Footer), styles = {
         wrapper: {
             display: "flex",
             flexDirection: "column",
@@ -32468,6 +32490,13 @@ var _bundleJs = []byte((((((((((`!function(modules) {
                     children = _react2.default.createElement("div", null, "Work in progress.");
                     break;
 
+                  case _common.MENU.get("consensus").id:
+                    children = _react2.default.createElement(_Consensus2.default, {
+                        content: content.consensus,
+                        shouldUpdate: shouldUpdate
+                    });
+                    break;
+
                   case _common.MENU.get("logs").id:
                     children = _react2.default.createElement(_Logs2.default, {
                         ref: function(_ref) {
@@ -40549,7 +40578,7 @@ var _bundleJs = []byte((((((((((`!function(modules) {
     }
     Object.defineProperty(exports, "__esModule", {
         value: !0
-    }), exports.bytePerSecPlotter = exports.bytePlotter = exports.percentPlotter = exports.multiplier = void 0;
+    }), exports.unitPlotter = exports.bytePerSecPlotter = exports.bytePlotter = exports.percentPlotter = exports.multiplier = void 0;
     var _createClass = function() {
         function defineProperties(target, props) {
             for (var i = 0; i < props.length; i++) {
@@ -40603,6 +40632,17 @@ var _bundleJs = []byte((((((((((`!function(modules) {
                 style: _common.styles.light
             }, text), " ", simplifyBytes(p), "/s");
         };
+    }, exports.unitPlotter = function(text) {
+        var unit = arguments.length > 1 && void 0 !== arguments[1] ? arguments[1] : "", mapper = arguments.length > 2 && void 0 !== arguments[2] ? arguments[2] : multiplier(1);
+        return function(payload) {
+            var p = mapper(payload);
+            return "number" != typeof p ? null : _react2.default.createElement(_Typography2.default, {
+                type: "caption",
+                color: "inherit"
+            }, _react2.default.createElement("span", {
+                style: _common.styles.light
+            }, text), " ", p.toFixed(2), " ", unit);
+        };
     }, function(_Component) {
         function CustomTooltip() {
             return _classCallCheck(this, CustomTooltip), _possibleConstructorReturn(this, (CustomTooltip.__proto__ || Object.getPrototypeOf(CustomTooltip)).apply(this, arguments));
@@ -40616,6 +40656,204 @@ var _bundleJs = []byte((((((((((`!function(modules) {
         } ]), CustomTooltip;
     }(_react.Component));
     exports.default = CustomTooltip;
+}, function(module, exports, __webpack_require__) {
+    "use strict";
+    function _interopRequireDefault(obj) {
+        return obj && obj.__esModule ? obj : {
+            default: obj
+        };
+    }
+    function _defineProperty(obj, key, value) {
+        return key in obj ? Object.defineProperty(obj, key, {
+            value: value,
+            enumerable: !0,
+            configurable: !0,
+            writable: !0
+        }) : obj[key] = value, obj;
+    }
+    function _classCallCheck(instance, Constructor) {
+        if (!(instance instanceof Constructor)) throw new TypeError("Cannot call a class as a function");
+    }
+    function _possibleConstructorReturn(self, call) {
+        if (!self) throw new ReferenceError("this hasn't been initialised - super() hasn't been called");
+        return !call || "object" != typeof call && "function" != typeof call ? self : call;
+    }
+    function _inherits(subClass, superClass) {
+        if ("function" != typeof superClass && null !== superClass) throw new TypeError("Super expression must either be null or a function, not " + typeof superClass);
+        subClass.prototype = Object.create(superClass && superClass.prototype, {
+            constructor: {
+                value: subClass,
+                enumerable: !1,
+                writable: !0,
+                configurable: !0
+            }
+        }), superClass && (Object.setPrototypeOf ? Object.setPrototypeOf(subClass, superClass) : subClass.__proto__ = superClass);
+    }
+    Object.defineProperty(exports, "__esModule", {
+        value: !0
+    });
+    var _createClass = function() {
+        function defineProperties(target, props) {
+            for (var i = 0; i < props.length; i++) {
+                var descriptor = props[i];
+                descriptor.enumerable = descriptor.enumerable || !1, descriptor.configurable = !0, 
+                "value" in descriptor && (descriptor.writable = !0), Object.defineProperty(target, descriptor.key, descriptor);
+            }
+        }
+        return function(Constructor, protoProps, staticProps) {
+            return protoProps && defineProperties(Constructor.prototype, protoProps), staticProps && defineProperties(Constructor, staticProps), 
+            Constructor;
+        };
+    }(), _react = __webpack_require__(0), _react2 = _interopRequireDefault(_react), _Grid = __webpack_require__(262), _Grid2 = _interopRequireDefault(_Grid), _Typography = __webpack_require__(113), _Typography2 = _interopRequireDefault(_Typography), _recharts = __webpack_require__(571), _CustomTooltip = __webpack_require__(948), _CustomTooltip2 = _interopRequireDefault(_CustomTooltip), CONSENSUS_SYNC_ID = "consensusSyncId", styles = {
+        chart: {
+            height: 120,
+            marginBottom: 24
+        },
+        section: {
+            marginBottom: 24
+        },
+        table: {
+            width: "100%",
+            borderCollapse: "collapse"
+        },
+        cell: {
+            padding: "4px 8px",
+            textAlign: "left"
+        },
+        numeric: {
+            padding: "4px 8px",
+            textAlign: "right"
+        },
+        connected: {
+            padding: "4px 8px",
+            color: "#4c8f0f"
+        },
+        disconnected: {
+            padding: "4px 8px",
+            color: "#ce3c23"
+        }
+    }, dkgPhase = function(status) {
+        return status.success ? "success" : status.final ? "final" : status.mpkReady ? "mpk ready" : "proposing";
+    }, Consensus = function(_Component) {
+        function Consensus() {
+            var _ref, _temp, _this, _ret;
+            _classCallCheck(this, Consensus);
+            for (var _len = arguments.length, args = Array(_len), _key = 0; _key < _len; _key++) args[_key] = arguments[_key];
+            return _temp = _this = _possibleConstructorReturn(this, (_ref = Consensus.__proto__ || Object.getPrototypeOf(Consensus)).call.apply(_ref, [ this ].concat(args))), 
+            _this.chart = function(title, dataKey, data, tooltip, color) {
+                return _react2.default.createElement("div", {
+                    style: styles.chart
+                }, _react2.default.createElement(_Typography2.default, {
+                    type: "caption"
+                }, title), _react2.default.createElement(_recharts.ResponsiveContainer, {
+                    width: "100%",
+                    height: "100%"
+                }, _react2.default.createElement(_recharts.AreaChart, {
+                    syncId: CONSENSUS_SYNC_ID,
+                    data: data.map(function(_ref2) {
+                        var value = _ref2.value;
+                        return _defineProperty({}, dataKey, value || 0);
+                    })
+                }, _react2.default.createElement(_recharts.Tooltip, {
+                    cursor: !1,
+                    content: _react2.default.createElement(_CustomTooltip2.default, {
+                        tooltip: tooltip
+                    })
+                }), _react2.default.createElement(_recharts.Area, {
+                    isAnimationActive: !1,
+                    type: "monotone",
+                    dataKey: dataKey,
+                    stroke: color,
+                    fill: color
+                }))));
+            }, _this.dkgTable = function(dkg) {
+                return _react2.default.createElement(_Typography2.default, {
+                    type: "body1",
+                    component: "div"
+                }, _react2.default.createElement("table", {
+                    style: styles.table
+                }, _react2.default.createElement("thead", null, _react2.default.createElement("tr", null, _react2.default.createElement("th", {
+                    style: styles.cell
+                }, "Round"), _react2.default.createElement("th", {
+                    style: styles.cell
+                }, "Phase"), _react2.default.createElement("th", {
+                    style: styles.numeric
+                }, "Resets"), _react2.default.createElement("th", {
+                    style: styles.numeric
+                }, "MPKs"), _react2.default.createElement("th", {
+                    style: styles.numeric
+                }, "Complaints"))), _react2.default.createElement("tbody", null, dkg.map(function(status) {
+                    return _react2.default.createElement("tr", {
+                        key: status.round
+                    }, _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, status.round), _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, dkgPhase(status)), _react2.default.createElement("td", {
+                        style: styles.numeric
+                    }, status.resetCount), _react2.default.createElement("td", {
+                        style: styles.numeric
+                    }, status.mpks), _react2.default.createElement("td", {
+                        style: styles.numeric
+                    }, status.complaints));
+                }))));
+            }, _this.peerGroup = function(group) {
+                return _react2.default.createElement("div", {
+                    key: group.label,
+                    style: styles.section
+                }, _react2.default.createElement(_Typography2.default, {
+                    type: "subheading"
+                }, group.label, group.member && " (member)"), _react2.default.createElement(_Typography2.default, {
+                    type: "body1",
+                    component: "div"
+                }, _react2.default.createElement("table", {
+                    style: styles.table
+                }, _react2.default.createElement("tbody", null, group.peers.map(function(peer) {
+                    return _react2.default.createElement("tr", {
+                        key: peer.id
+                    }, _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, peer.id.substring(0, 16)), _react2.default.createElement("td", {
+                        style: peer.connected ? styles.connected : styles.disconnected
+                    }, peer.connected ? "connected" : "disconnected"), _react2.default.createElement("td", {
+                        style: styles.numeric
+                    }, peer.number));
+                })))));
+            }, _ret = _temp, _possibleConstructorReturn(_this, _ret);
+        }
+        return _inherits(Consensus, _Component), _createClass(Consensus, [ {
+            key: "shouldComponentUpdate",
+            value: function(nextProps) {
+                return void 0 !== nextProps.shouldUpdate.consensus;
+            }
+        }, {
+            key: "render",
+            value: function() {
+                var content = this.props.content;
+                return _react2.default.createElement(_Grid2.default, {
+                    container: !0,
+                    spacing: 24
+                }, _react2.default.createElement(_Grid2.default, {
+                    item: !0,
+                    xs: 12,
+                    md: 6
+                }, this.chart("BA agreement latency", "agreementLatency", content.agreementLatency, (0, 
+                _CustomTooltip.unitPlotter)("Latency", "ms"), "#8884d8"), this.chart("Votes per position", "votesPerPosition", content.votesPerPosition, (0, 
+                _CustomTooltip.unitPlotter)("Votes"), "#82ca9d"), this.chart("Agreement results", "agreementResults", content.agreementResults, (0, 
+                _CustomTooltip.unitPlotter)("Results", "/s"), "#8884d8"), this.chart("Finalization lag", "finalizationLag", content.finalizationLag, (0, 
+                _CustomTooltip.unitPlotter)("Lag", "blocks"), "#82ca9d")), _react2.default.createElement(_Grid2.default, {
+                    item: !0,
+                    xs: 12,
+                    md: 6
+                }, _react2.default.createElement("div", {
+                    style: styles.section
+                }, _react2.default.createElement(_Typography2.default, {
+                    type: "subheading"
+                }, "DKG"), this.dkgTable(content.dkg)), content.peers.map(this.peerGroup)));
+            }
+        } ]), Consensus;
+    }(_react.Component);
+    exports.default = Consensus;
 } ]);`)))))))))))
 
 func bundleJsBytes() ([]byte, error) {
@@ -40629,7 +40867,7 @@ func bundleJs() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "bundle.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xef, 0x26, 0x2b, 0x98, 0x2d, 0xce, 0x9a, 0x8f, 0x9a, 0x5e, 0x37, 0x64, 0x5c, 0x70, 0xa2, 0xeb, 0x44, 0xb1, 0x6d, 0x8b, 0x8e, 0x95, 0x34, 0x14, 0x7a, 0x79, 0x4f, 0x8, 0xc3, 0xb3, 0x5, 0x3}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1a, 0xe4, 0xd4, 0xc0, 0xc3, 0xb5, 0xf3, 0x7e, 0xf4, 0xeb, 0x63, 0x68, 0x1c, 0xdb, 0xb9, 0x34, 0x4, 0xb3, 0x44, 0x35, 0x13, 0x0, 0x62, 0x25, 0x54, 0x14, 0x3e, 0x78, 0x9, 0x2a, 0x11, 0xe4}}
 	return a, nil
 }
 
@@ -48,6 +48,12 @@ const menuSkeletons: Array<{|id: string, menu: ProvidedMenuProp|}> = [
 			title: 'System',
 			icon:  'tachometer',
 		},
+	}, {
+		id:   'consensus',
+		menu: {
+			title: 'Consensus',
+			icon:  'handshake-o',
+		},
 	}, {
 		id:   'logs',
 		menu: {
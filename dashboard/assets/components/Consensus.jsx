@@ -0,0 +1,174 @@
+// @flow
+
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+import React, {Component} from 'react';
+
+import Grid from 'material-ui/Grid';
+import Typography from 'material-ui/Typography';
+import {ResponsiveContainer, AreaChart, Area, Tooltip} from 'recharts';
+
+import CustomTooltip, {unitPlotter} from './CustomTooltip';
+import type {Consensus as ConsensusType, DKGStatus, PeerGroup} from '../types/content';
+
+const CONSENSUS_SYNC_ID = 'consensusSyncId';
+
+// styles contains the constant styles of the component.
+const styles = {
+	chart: {
+		height:       120,
+		marginBottom: 24,
+	},
+	section: {
+		marginBottom: 24,
+	},
+	table: {
+		width:          '100%',
+		borderCollapse: 'collapse',
+	},
+	cell: {
+		padding:   '4px 8px',
+		textAlign: 'left',
+	},
+	numeric: {
+		padding:   '4px 8px',
+		textAlign: 'right',
+	},
+	connected: {
+		padding: '4px 8px',
+		color:   '#4c8f0f',
+	},
+	disconnected: {
+		padding: '4px 8px',
+		color:   '#ce3c23',
+	},
+};
+
+// dkgPhase returns the furthest phase the DKG protocol of a round has reached.
+const dkgPhase = (status: DKGStatus) => {
+	if (status.success) {
+		return 'success';
+	}
+	if (status.final) {
+		return 'final';
+	}
+	if (status.mpkReady) {
+		return 'mpk ready';
+	}
+	return 'proposing';
+};
+
+export type Props = {
+	content:      ConsensusType,
+	shouldUpdate: Object,
+};
+
+// Consensus renders the health of the DEXON consensus core.
+class Consensus extends Component<Props> {
+	shouldComponentUpdate(nextProps) {
+		return typeof nextProps.shouldUpdate.consensus !== 'undefined';
+	}
+
+	// chart renders a titled area chart of the given samples.
+	chart = (title, dataKey, data, tooltip, color) => (
+		<div style={styles.chart}>
+			<Typography type='caption'>{title}</Typography>
+			<ResponsiveContainer width='100%' height='100%'>
+				<AreaChart syncId={CONSENSUS_SYNC_ID} data={data.map(({value}) => ({[dataKey]: value || 0}))}>
+					<Tooltip cursor={false} content={<CustomTooltip tooltip={tooltip} />} />
+					<Area isAnimationActive={false} type='monotone' dataKey={dataKey} stroke={color} fill={color} />
+				</AreaChart>
+			</ResponsiveContainer>
+		</div>
+	);
+
+	// dkgTable renders the DKG progress of the recent rounds.
+	dkgTable = (dkg: Array<DKGStatus>) => (
+		<Typography type='body1' component='div'>
+			<table style={styles.table}>
+				<thead>
+					<tr>
+						<th style={styles.cell}>Round</th>
+						<th style={styles.cell}>Phase</th>
+						<th style={styles.numeric}>Resets</th>
+						<th style={styles.numeric}>MPKs</th>
+						<th style={styles.numeric}>Complaints</th>
+					</tr>
+				</thead>
+				<tbody>
+					{dkg.map(status => (
+						<tr key={status.round}>
+							<td style={styles.cell}>{status.round}</td>
+							<td style={styles.cell}>{dkgPhase(status)}</td>
+							<td style={styles.numeric}>{status.resetCount}</td>
+							<td style={styles.numeric}>{status.mpks}</td>
+							<td style={styles.numeric}>{status.complaints}</td>
+						</tr>
+					))}
+				</tbody>
+			</table>
+		</Typography>
+	);
+
+	// peerGroup renders the members of a notary set.
+	peerGroup = (group: PeerGroup) => (
+		<div key={group.label} style={styles.section}>
+			<Typography type='subheading'>
+				{group.label}{group.member && ' (member)'}
+			</Typography>
+			<Typography type='body1' component='div'>
+				<table style={styles.table}>
+					<tbody>
+						{group.peers.map(peer => (
+							<tr key={peer.id}>
+								<td style={styles.cell}>{peer.id.substring(0, 16)}</td>
+								<td style={peer.connected ? styles.connected : styles.disconnected}>
+									{peer.connected ? 'connected' : 'disconnected'}
+								</td>
+								<td style={styles.numeric}>{peer.number}</td>
+							</tr>
+						))}
+					</tbody>
+				</table>
+			</Typography>
+		</div>
+	);
+
+	render() {
+		const {content} = this.props;
+
+		return (
+			<Grid container spacing={24}>
+				<Grid item xs={12} md={6}>
+					{this.chart('BA agreement latency', 'agreementLatency', content.agreementLatency, unitPlotter('Latency', 'ms'), '#8884d8')}
+					{this.chart('Votes per position', 'votesPerPosition', content.votesPerPosition, unitPlotter('Votes'), '#82ca9d')}
+					{this.chart('Agreement results', 'agreementResults', content.agreementResults, unitPlotter('Results', '/s'), '#8884d8')}
+					{this.chart('Finalization lag', 'finalizationLag', content.finalizationLag, unitPlotter('Lag', 'blocks'), '#82ca9d')}
+				</Grid>
+				<Grid item xs={12} md={6}>
+					<div style={styles.section}>
+						<Typography type='subheading'>DKG</Typography>
+						{this.dkgTable(content.dkg)}
+					</div>
+					{content.peers.map(this.peerGroup)}
+				</Grid>
+			</Grid>
+		);
+	}
+}
+
+export default Consensus;
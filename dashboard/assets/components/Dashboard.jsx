@@ -99,6 +99,14 @@ const defaultContent: () => Content = () => ({
 		diskRead:       [],
 		diskWrite:      [],
 	},
+	consensus: {
+		agreementLatency: [],
+		votesPerPosition: [],
+		agreementResults: [],
+		finalizationLag:  [],
+		dkg:              [],
+		peers:            [],
+	},
 	logs: {
 		chunks:        [],
 		endTop:        false,
@@ -130,6 +138,14 @@ const updaters = {
 		diskRead:       appender(200),
 		diskWrite:      appender(200),
 	},
+	consensus: {
+		agreementLatency: appender(200),
+		votesPerPosition: appender(200),
+		agreementResults: appender(200),
+		finalizationLag:  appender(200),
+		dkg:              replacer,
+		peers:            replacer,
+	},
 	logs: logInserter(5),
 };
 
@@ -22,6 +22,7 @@ import withStyles from 'material-ui/styles/withStyles';
 
 import {MENU} from '../common';
 import Logs from './Logs';
+import Consensus from './Consensus';
 import Footer from './Footer';
 import type {Content} from '../types/content';
 
@@ -95,6 +96,9 @@ class Main extends Component<Props> {
 		case MENU.get('system').id:
 			children = <div>Work in progress.</div>;
 			break;
+		case MENU.get('consensus').id:
+			children = <Consensus content={content.consensus} shouldUpdate={shouldUpdate} />;
+			break;
 		case MENU.get('logs').id:
 			children = (
 				<Logs
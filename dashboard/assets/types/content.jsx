@@ -22,8 +22,9 @@ export type Content = {
 	chain:   Chain,
 	txpool:  TxPool,
 	network: Network,
-	system:  System,
-	logs:    Logs,
+	system:    System,
+	consensus: Consensus,
+	logs:      Logs,
 };
 
 export type ChartEntries = Array<ChartEntry>;
@@ -65,6 +66,37 @@ export type System = {
 	diskWrite:      ChartEntries,
 };
 
+export type Consensus = {
+	agreementLatency: ChartEntries,
+	votesPerPosition: ChartEntries,
+	agreementResults: ChartEntries,
+	finalizationLag:  ChartEntries,
+	dkg:              Array<DKGStatus>,
+	peers:            Array<PeerGroup>,
+};
+
+export type DKGStatus = {
+	round:      number,
+	resetCount: number,
+	mpks:       number,
+	complaints: number,
+	mpkReady:   boolean,
+	final:      boolean,
+	success:    boolean,
+};
+
+export type PeerGroup = {
+	label:  string,
+	member: boolean,
+	peers:  Array<PeerEntry>,
+};
+
+export type PeerEntry = {
+	id:        string,
+	number:    number,
+	connected: boolean,
+};
+
 export type Record = {
 	t:   string,
 	lvl: Object,
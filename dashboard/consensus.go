@@ -0,0 +1,204 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package dashboard
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dexon-foundation/dexon/dex"
+	"github.com/dexon-foundation/dexon/log"
+	"github.com/dexon-foundation/dexon/metrics"
+)
+
+const dkgStatusRounds = 3 // Number of recent rounds to report the DKG progress of
+
+// gaugeCollector returns a function, which retrieves a specific gauge.
+func gaugeCollector(name string) func() int64 {
+	if metric := metrics.DefaultRegistry.Get(name); metric != nil {
+		g := metric.(metrics.Gauge)
+		return func() int64 {
+			return g.Value()
+		}
+	}
+	return func() int64 {
+		return 0
+	}
+}
+
+// collectConsensusData collects the consensus core health to plot on the
+// dashboard.
+func (db *Dashboard) collectConsensusData() {
+	defer db.wg.Done()
+
+	var (
+		collectVotes            = meterCollector("dex/prop/votes/in/packets")
+		collectAgreements       = meterCollector("dex/prop/agreement/in/packets")
+		collectAgreementLatency = gaugeCollector("dex/prop/blockconfirm/latency")
+		collectConfirmedHeight  = gaugeCollector("dex/prop/blockconfirm/height")
+
+		prevVotes           = collectVotes()
+		prevAgreements      = collectAgreements()
+		prevConfirmedHeight = collectConfirmedHeight()
+
+		frequency = float64(db.config.Refresh / time.Second)
+	)
+
+	for {
+		select {
+		case errc := <-db.quit:
+			errc <- nil
+			return
+		case <-time.After(db.config.Refresh):
+			var (
+				curVotes           = collectVotes()
+				curAgreements      = collectAgreements()
+				curConfirmedHeight = collectConfirmedHeight()
+
+				deltaVotes           = curVotes - prevVotes
+				deltaAgreements      = curAgreements - prevAgreements
+				deltaConfirmedHeight = curConfirmedHeight - prevConfirmedHeight
+			)
+			prevVotes = curVotes
+			prevAgreements = curAgreements
+			prevConfirmedHeight = curConfirmedHeight
+
+			var votesPerPosition float64
+			if deltaConfirmedHeight > 0 {
+				votesPerPosition = float64(deltaVotes) / float64(deltaConfirmedHeight)
+			}
+			var lag int64
+			if head := int64(db.dex.BlockChain().CurrentBlock().NumberU64()); curConfirmedHeight > head {
+				lag = curConfirmedHeight - head
+			}
+
+			now := time.Now()
+
+			agreementLatency := &ChartEntry{
+				Time:  now,
+				Value: float64(collectAgreementLatency()) / 1000,
+			}
+			votes := &ChartEntry{
+				Time:  now,
+				Value: votesPerPosition,
+			}
+			agreementResults := &ChartEntry{
+				Time:  now,
+				Value: float64(deltaAgreements) / frequency,
+			}
+			finalizationLag := &ChartEntry{
+				Time:  now,
+				Value: float64(lag),
+			}
+			dkg := db.collectDKGStatus()
+			peers := db.collectPeerGroups()
+
+			cons := db.history.Consensus
+			db.lock.Lock()
+			cons.AgreementLatency = append(cons.AgreementLatency[1:], agreementLatency)
+			cons.VotesPerPosition = append(cons.VotesPerPosition[1:], votes)
+			cons.AgreementResults = append(cons.AgreementResults[1:], agreementResults)
+			cons.FinalizationLag = append(cons.FinalizationLag[1:], finalizationLag)
+			cons.DKG = dkg
+			cons.Peers = peers
+			db.lock.Unlock()
+
+			db.sendToAll(&Message{
+				Consensus: &ConsensusMessage{
+					AgreementLatency: ChartEntries{agreementLatency},
+					VotesPerPosition: ChartEntries{votes},
+					AgreementResults: ChartEntries{agreementResults},
+					FinalizationLag:  ChartEntries{finalizationLag},
+					DKG:              dkg,
+					Peers:            peers,
+				},
+			})
+		}
+	}
+}
+
+// collectDKGStatus retrieves the DKG progress of the ongoing DKG round and the
+// ones preceding it.
+func (db *Dashboard) collectDKGStatus() []*DKGStatus {
+	gov := db.dex.Governance()
+
+	last := gov.GetHeadState().DKGRound().Uint64()
+	var first uint64
+	if last >= dkgStatusRounds {
+		first = last - dkgStatusRounds + 1
+	}
+	statuses := make([]*DKGStatus, 0, last-first+1)
+	for round := first; round <= last; round++ {
+		statuses = append(statuses, &DKGStatus{
+			Round:      round,
+			ResetCount: gov.DKGResetCount(round),
+			MPKs:       len(gov.DKGMasterPublicKeys(round)),
+			Complaints: len(gov.DKGComplaints(round)),
+			MPKReady:   gov.IsDKGMPKReady(round),
+			Final:      gov.IsDKGFinal(round),
+			Success:    gov.IsDKGSuccess(round),
+		})
+	}
+	return statuses
+}
+
+// collectPeerGroups retrieves the members of the current and the next notary
+// set along with their connection state.
+func (db *Dashboard) collectPeerGroups() []*PeerGroup {
+	info, err := db.dex.NotaryInfo()
+	if err != nil {
+		log.Debug("Failed to retrieve notary info", "err", err)
+		return nil
+	}
+	groups := []*PeerGroup{newPeerGroup(info.Round, db.isNotary(info.Round), info.Nodes)}
+	if info.Next != nil {
+		groups = append(groups, newPeerGroup(info.NextRound, db.isNotary(info.NextRound), info.Next))
+	}
+	return groups
+}
+
+// isNotary returns whether the local node is in the notary set of the given
+// round.
+func (db *Dashboard) isNotary(round uint64) bool {
+	member, err := db.dex.Governance().IsNotary(round)
+	if err != nil {
+		log.Debug("Failed to retrieve notary set", "round", round, "err", err)
+	}
+	return member
+}
+
+// newPeerGroup creates the peer group of the notary set of the given round,
+// ordered by node ID.
+func newPeerGroup(round uint64, member bool, nodes []*dex.NotaryNodeInfo) *PeerGroup {
+	group := &PeerGroup{
+		Label:  fmt.Sprintf("NotarySet round: %d", round),
+		Member: member,
+		Peers:  make([]*PeerEntry, 0, len(nodes)),
+	}
+	for _, node := range nodes {
+		group.Peers = append(group.Peers, &PeerEntry{
+			ID:        node.ID.String(),
+			Number:    node.Number,
+			Connected: node.Connected,
+		})
+	}
+	sort.Slice(group.Peers, func(i, j int) bool {
+		return group.Peers[i].ID < group.Peers[j].ID
+	})
+	return group
+}
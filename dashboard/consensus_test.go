@@ -0,0 +1,68 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package dashboard
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/dex"
+	"github.com/dexon-foundation/dexon/metrics"
+	"github.com/dexon-foundation/dexon/p2p/enode"
+)
+
+func TestGaugeCollector(t *testing.T) {
+	if v := gaugeCollector("dashboard/test/missing")(); v != 0 {
+		t.Errorf("missing gauge value mismatch: have %d, want 0", v)
+	}
+	gauge := new(metrics.StandardGauge)
+	metrics.DefaultRegistry.Register("dashboard/test/gauge", gauge)
+	defer metrics.DefaultRegistry.Unregister("dashboard/test/gauge")
+
+	collect := gaugeCollector("dashboard/test/gauge")
+	gauge.Update(42)
+	if v := collect(); v != 42 {
+		t.Errorf("gauge value mismatch: have %d, want 42", v)
+	}
+	gauge.Update(7)
+	if v := collect(); v != 7 {
+		t.Errorf("updated gauge value mismatch: have %d, want 7", v)
+	}
+}
+
+func TestNewPeerGroup(t *testing.T) {
+	nodes := []*dex.NotaryNodeInfo{
+		{ID: enode.ID{0x02}, Number: 20, Connected: false},
+		{ID: enode.ID{0x01}, Number: 10, Connected: true},
+	}
+	want := &PeerGroup{
+		Label:  "NotarySet round: 3",
+		Member: true,
+		Peers: []*PeerEntry{
+			{ID: enode.ID{0x01}.String(), Number: 10, Connected: true},
+			{ID: enode.ID{0x02}.String(), Number: 20, Connected: false},
+		},
+	}
+	if group := newPeerGroup(3, true, nodes); !reflect.DeepEqual(group, want) {
+		t.Errorf("peer group mismatch: have %+v, want %+v", group, want)
+	}
+	// Empty notary sets are reported with an empty, non-nil peer list.
+	group := newPeerGroup(4, false, nil)
+	if group.Member || group.Peers == nil || len(group.Peers) != 0 {
+		t.Errorf("empty peer group mismatch: %+v", group)
+	}
+}
@@ -34,6 +34,7 @@ import (
 
 	"io"
 
+	"github.com/dexon-foundation/dexon/dex"
 	"github.com/dexon-foundation/dexon/log"
 	"github.com/dexon-foundation/dexon/metrics"
 	"github.com/dexon-foundation/dexon/p2p"
@@ -45,14 +46,18 @@ import (
 )
 
 const (
-	activeMemorySampleLimit   = 200 // Maximum number of active memory data samples
-	virtualMemorySampleLimit  = 200 // Maximum number of virtual memory data samples
-	networkIngressSampleLimit = 200 // Maximum number of network ingress data samples
-	networkEgressSampleLimit  = 200 // Maximum number of network egress data samples
-	processCPUSampleLimit     = 200 // Maximum number of process cpu data samples
-	systemCPUSampleLimit      = 200 // Maximum number of system cpu data samples
-	diskReadSampleLimit       = 200 // Maximum number of disk read data samples
-	diskWriteSampleLimit      = 200 // Maximum number of disk write data samples
+	activeMemorySampleLimit     = 200 // Maximum number of active memory data samples
+	virtualMemorySampleLimit    = 200 // Maximum number of virtual memory data samples
+	networkIngressSampleLimit   = 200 // Maximum number of network ingress data samples
+	networkEgressSampleLimit    = 200 // Maximum number of network egress data samples
+	processCPUSampleLimit       = 200 // Maximum number of process cpu data samples
+	systemCPUSampleLimit        = 200 // Maximum number of system cpu data samples
+	diskReadSampleLimit         = 200 // Maximum number of disk read data samples
+	diskWriteSampleLimit        = 200 // Maximum number of disk write data samples
+	agreementLatencySampleLimit = 200 // Maximum number of agreement latency data samples
+	votesPerPositionSampleLimit = 200 // Maximum number of votes per position data samples
+	agreementResultSampleLimit  = 200 // Maximum number of agreement result data samples
+	finalizationLagSampleLimit  = 200 // Maximum number of finalization lag data samples
 )
 
 var nextID uint32 // Next connection id
@@ -67,6 +72,7 @@ type Dashboard struct {
 	lock     sync.RWMutex // Lock protecting the dashboard's internals
 
 	logdir string
+	dex    *dex.Dexon // DEXON service feeding the consensus panel, nil if not running
 
 	quit chan chan error // Channel used for graceful exit
 	wg   sync.WaitGroup
@@ -80,7 +86,7 @@ type client struct {
 }
 
 // New creates a new dashboard instance with the given configuration.
-func New(config *Config, commit string, logdir string) *Dashboard {
+func New(config *Config, commit string, logdir string, dexServ *dex.Dexon) *Dashboard {
 	now := time.Now()
 	versionMeta := ""
 	if len(params.VersionMeta) > 0 {
@@ -105,8 +111,15 @@ func New(config *Config, commit string, logdir string) *Dashboard {
 				DiskRead:       emptyChartEntries(now, diskReadSampleLimit, config.Refresh),
 				DiskWrite:      emptyChartEntries(now, diskWriteSampleLimit, config.Refresh),
 			},
+			Consensus: &ConsensusMessage{
+				AgreementLatency: emptyChartEntries(now, agreementLatencySampleLimit, config.Refresh),
+				VotesPerPosition: emptyChartEntries(now, votesPerPositionSampleLimit, config.Refresh),
+				AgreementResults: emptyChartEntries(now, agreementResultSampleLimit, config.Refresh),
+				FinalizationLag:  emptyChartEntries(now, finalizationLagSampleLimit, config.Refresh),
+			},
 		},
 		logdir: logdir,
+		dex:    dexServ,
 	}
 }
 
@@ -135,6 +148,10 @@ func (db *Dashboard) Start(server *p2p.Server) error {
 	db.wg.Add(2)
 	go db.collectData()
 	go db.streamLogs()
+	if db.dex != nil {
+		db.wg.Add(1)
+		go db.collectConsensusData()
+	}
 
 	http.HandleFunc("/", db.webHandler)
 	http.Handle("/api", websocket.Handler(db.apiHandler))
@@ -159,8 +176,12 @@ func (db *Dashboard) Stop() error {
 		errs = append(errs, err)
 	}
 	// Close the collectors.
+	collectors := 2
+	if db.dex != nil {
+		collectors++
+	}
 	errc := make(chan error, 1)
-	for i := 0; i < 2; i++ {
+	for i := 0; i < collectors; i++ {
 		db.quit <- errc
 		if err := <-errc; err != nil {
 			errs = append(errs, err)
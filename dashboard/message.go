@@ -22,13 +22,14 @@ import (
 )
 
 type Message struct {
-	General *GeneralMessage `json:"general,omitempty"`
-	Home    *HomeMessage    `json:"home,omitempty"`
-	Chain   *ChainMessage   `json:"chain,omitempty"`
-	TxPool  *TxPoolMessage  `json:"txpool,omitempty"`
-	Network *NetworkMessage `json:"network,omitempty"`
-	System  *SystemMessage  `json:"system,omitempty"`
-	Logs    *LogsMessage    `json:"logs,omitempty"`
+	General   *GeneralMessage   `json:"general,omitempty"`
+	Home      *HomeMessage      `json:"home,omitempty"`
+	Chain     *ChainMessage     `json:"chain,omitempty"`
+	TxPool    *TxPoolMessage    `json:"txpool,omitempty"`
+	Network   *NetworkMessage   `json:"network,omitempty"`
+	System    *SystemMessage    `json:"system,omitempty"`
+	Consensus *ConsensusMessage `json:"consensus,omitempty"`
+	Logs      *LogsMessage      `json:"logs,omitempty"`
 }
 
 type ChartEntries []*ChartEntry
@@ -70,6 +71,42 @@ type SystemMessage struct {
 	DiskWrite      ChartEntries `json:"diskWrite,omitempty"`
 }
 
+// ConsensusMessage contains the health of the DEXON consensus core.
+type ConsensusMessage struct {
+	AgreementLatency ChartEntries `json:"agreementLatency,omitempty"` // Time between block proposal and confirmation in ms.
+	VotesPerPosition ChartEntries `json:"votesPerPosition,omitempty"` // Received votes per confirmed position.
+	AgreementResults ChartEntries `json:"agreementResults,omitempty"` // Received agreement results per second.
+	FinalizationLag  ChartEntries `json:"finalizationLag,omitempty"`  // Confirmed blocks not yet in the block chain.
+
+	DKG   []*DKGStatus `json:"dkg,omitempty"`   // DKG progress of the recent rounds.
+	Peers []*PeerGroup `json:"peers,omitempty"` // Peers grouped by notary set.
+}
+
+// DKGStatus contains the DKG progress of a round.
+type DKGStatus struct {
+	Round      uint64 `json:"round"`
+	ResetCount uint64 `json:"resetCount"`
+	MPKs       int    `json:"mpks"`       // Number of proposed master public keys.
+	Complaints int    `json:"complaints"` // Number of proposed complaints.
+	MPKReady   bool   `json:"mpkReady"`
+	Final      bool   `json:"final"`
+	Success    bool   `json:"success"`
+}
+
+// PeerGroup contains the members of a notary set labelled by round.
+type PeerGroup struct {
+	Label  string       `json:"label"`
+	Member bool         `json:"member"` // Denotes whether the local node is in the set.
+	Peers  []*PeerEntry `json:"peers"`
+}
+
+// PeerEntry contains the state of a notary set member.
+type PeerEntry struct {
+	ID        string `json:"id"`
+	Number    uint64 `json:"number"`
+	Connected bool   `json:"connected"`
+}
+
 // LogsMessage wraps up a log chunk. If Source isn't present, the chunk is a stream chunk.
 type LogsMessage struct {
 	Source *LogFile        `json:"source,omitempty"` // Attributes of the log file.
@@ -17,14 +17,19 @@
 package dex
 
 import (
+	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
+	dexCore "github.com/dexon-foundation/dexon-consensus/core"
+
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/common/hexutil"
 	"github.com/dexon-foundation/dexon/core"
@@ -143,6 +148,30 @@ func (api *PrivateAdminAPI) NotaryInfo() (*NotaryInfo, error) {
 	return api.dex.protocolManager.NotaryInfo()
 }
 
+// NotarySet returns the node public keys of the notary set of the given round.
+func (api *PrivateAdminAPI) NotarySet(round uint64) ([]hexutil.Bytes, error) {
+	// The notary set is only known once the CRS of the round is proposed.
+	if round > dexCore.DKGDelayRound && round > api.dex.governance.CRSRound() {
+		return nil, fmt.Errorf("notary set of round %d not known yet", round)
+	}
+	notarySet, err := api.dex.governance.NotarySet(round)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]hexutil.Bytes, 0, len(notarySet))
+	for key := range notarySet {
+		pk, err := hex.DecodeString(key)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, pk)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	return keys, nil
+}
+
 // PublicDebugAPI is the collection of Ethereum full node APIs exposed
 // over the public debugging endpoint.
 type PublicDebugAPI struct {
@@ -18,6 +18,7 @@ package dex
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/dexon-foundation/dexon/accounts"
@@ -110,6 +111,20 @@ func (b *DexAPIBackend) GetTd(blockHash common.Hash) *big.Int {
 	return b.dex.blockchain.GetTdByHash(blockHash)
 }
 
+func (b *DexAPIBackend) GetRoundRange(round uint64) (uint64, uint64, error) {
+	gov := b.dex.governance
+	start := gov.GetRoundHeight(round)
+	if round != 0 && start == 0 {
+		return 0, 0, fmt.Errorf("round %d not started", round)
+	}
+	end := start + gov.DexconConfiguration(round).RoundLength - 1
+	// Round 0 starts at height 0 instead of height 1.
+	if round == 0 {
+		end += 1
+	}
+	return start, end, nil
+}
+
 func (b *DexAPIBackend) GetEVM(ctx context.Context, msg core.Message, state *state.StateDB, header *types.Header) (*vm.EVM, func() error, error) {
 	state.SetBalance(msg.From(), math.MaxBig256)
 	vmError := func() error { return nil }
@@ -186,8 +201,51 @@ func (b *DexAPIBackend) ProtocolVersion() int {
 	return b.dex.DexVersion()
 }
 
+// minGasPriceLookahead is the number of blocks before the end of a round
+// from which the next round's MinGasPrice is also taken into account when
+// suggesting a gas price.
+const minGasPriceLookahead = 64
+
+// SuggestPrice returns the gas price suggested by recent blocks, raised to
+// the MinGasPrice enforced by governance. Near a round boundary the next
+// round's MinGasPrice is honoured as well, so that pending transactions are
+// not dropped from the pool when the round changes.
 func (b *DexAPIBackend) SuggestPrice(ctx context.Context) (*big.Int, error) {
-	return b.dex.governance.MinGasPrice(b.dex.blockchain.CurrentBlock().Round()), nil
+	price, err := b.gpo.SuggestPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if min := b.minGasPrice(); price.Cmp(min) < 0 {
+		price = min
+	}
+	return price, nil
+}
+
+// gasPriceGovernance is the part of the governance the gas price floor is
+// derived from.
+type gasPriceGovernance interface {
+	MinGasPrice(round uint64) *big.Int
+	GetRoundHeight(round uint64) uint64
+	DexconConfiguration(round uint64) *params.DexconConfig
+}
+
+// minGasPrice returns the lowest gas price accepted by the transaction pool
+// for the current round and, near the end of it, the next round.
+func (b *DexAPIBackend) minGasPrice() *big.Int {
+	head := b.dex.blockchain.CurrentBlock()
+	return minGasPriceAt(b.dex.governance, head.Round(), head.NumberU64())
+}
+
+// minGasPriceAt returns the gas price floor at the given height of a round.
+func minGasPriceAt(gov gasPriceGovernance, round, height uint64) *big.Int {
+	min := gov.MinGasPrice(round)
+	end := gov.GetRoundHeight(round) + gov.DexconConfiguration(round).RoundLength
+	if height+minGasPriceLookahead >= end {
+		if next := gov.MinGasPrice(round + 1); next.Cmp(min) > 0 {
+			min = next
+		}
+	}
+	return min
 }
 
 func (b *DexAPIBackend) ChainDb() ethdb.Database {
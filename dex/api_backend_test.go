@@ -0,0 +1,242 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package dex
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/eth/gasprice"
+	"github.com/dexon-foundation/dexon/internal/ethapi"
+	"github.com/dexon-foundation/dexon/params"
+)
+
+func TestGetRoundRange(t *testing.T) {
+	masterKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	dex, _, err := newDexon(masterKey, 0)
+	if err != nil {
+		t.Fatalf("failed to create dexon: %v", err)
+	}
+
+	// Round 0 starts at height 0 and lasts one block longer.
+	start, end, err := dex.APIBackend.GetRoundRange(0)
+	if err != nil {
+		t.Fatalf("failed to get range of round 0: %v", err)
+	}
+	if start != 0 || end != 600 {
+		t.Errorf("round 0 range mismatch: have [%d, %d], want [0, 600]", start, end)
+	}
+
+	if _, _, err := dex.APIBackend.GetRoundRange(1); err == nil {
+		t.Errorf("expected error for round not started")
+	}
+}
+
+func TestEstimateGasMargin(t *testing.T) {
+	masterKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	dex, accounts, err := newDexon(masterKey, 1)
+	if err != nil {
+		t.Fatalf("failed to create dexon: %v", err)
+	}
+	dex.config = &Config{}
+	api := ethapi.NewPublicBlockChainAPI(dex.APIBackend)
+
+	to := common.HexToAddress("0x1337")
+	args := ethapi.CallArgs{
+		From:  crypto.PubkeyToAddress(accounts[0].PublicKey),
+		To:    &to,
+		Value: hexutil.Big(*big.NewInt(1)),
+	}
+	gasLimit := dex.blockchain.CurrentBlock().GasLimit()
+
+	tests := []struct {
+		margin *hexutil.Uint64
+		want   uint64
+	}{
+		{nil, 21000},
+		{newUint64(0), 21000},
+		{newUint64(20), 25200},
+		{newUint64(1 << 60), gasLimit},
+	}
+	for i, tt := range tests {
+		gas, err := api.EstimateGas(context.Background(), args, tt.margin)
+		if err != nil {
+			t.Fatalf("test %d: failed to estimate gas: %v", i, err)
+		}
+		if uint64(gas) != tt.want {
+			t.Errorf("test %d: gas mismatch: have %d, want %d", i, gas, tt.want)
+		}
+	}
+}
+
+func TestEstimateFee(t *testing.T) {
+	masterKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	dex, accounts, err := newDexon(masterKey, 1)
+	if err != nil {
+		t.Fatalf("failed to create dexon: %v", err)
+	}
+	dex.config = &Config{}
+	api := ethapi.NewPublicBlockChainAPI(dex.APIBackend)
+
+	to := common.HexToAddress("0x1337")
+	price := big.NewInt(1e9)
+	args := ethapi.CallArgs{
+		From:     crypto.PubkeyToAddress(accounts[0].PublicKey),
+		To:       &to,
+		Value:    hexutil.Big(*big.NewInt(1)),
+		GasPrice: hexutil.Big(*price),
+	}
+
+	// Unused gas is not refunded, so the whole gas limit is charged.
+	tests := []struct {
+		gas    hexutil.Uint64
+		margin *hexutil.Uint64
+		want   uint64
+	}{
+		{0, nil, 21000},
+		{0, newUint64(10), 23100},
+		{30000, newUint64(10), 30000},
+	}
+	for i, tt := range tests {
+		args.Gas = tt.gas
+		fee, err := api.EstimateFee(context.Background(), args, tt.margin)
+		if err != nil {
+			t.Fatalf("test %d: failed to estimate fee: %v", i, err)
+		}
+		if uint64(fee.Gas) != tt.want {
+			t.Errorf("test %d: gas mismatch: have %d, want %d", i, fee.Gas, tt.want)
+		}
+		if fee.GasRefund != 0 {
+			t.Errorf("test %d: refund mismatch: have %d, want 0", i, fee.GasRefund)
+		}
+		if fee.GasPrice.ToInt().Cmp(price) != 0 {
+			t.Errorf("test %d: gas price mismatch: have %v, want %v", i, fee.GasPrice.ToInt(), price)
+		}
+		want := new(big.Int).Mul(price, new(big.Int).SetUint64(tt.want))
+		if fee.Fee.ToInt().Cmp(want) != 0 {
+			t.Errorf("test %d: fee mismatch: have %v, want %v", i, fee.Fee.ToInt(), want)
+		}
+	}
+}
+
+func TestSuggestPriceFloor(t *testing.T) {
+	masterKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	dex, _, err := newDexon(masterKey, 0)
+	if err != nil {
+		t.Fatalf("failed to create dexon: %v", err)
+	}
+	floor := dex.governance.MinGasPrice(0)
+	above := new(big.Int).Add(floor, big.NewInt(1))
+
+	tests := []struct {
+		suggested *big.Int
+		want      *big.Int
+	}{
+		{big.NewInt(1), floor},
+		{floor, floor},
+		{above, above},
+	}
+	for i, tt := range tests {
+		// Without recent transactions the oracle suggests its default price.
+		dex.APIBackend.gpo = gasprice.NewOracle(dex.APIBackend, gasprice.Config{
+			Blocks:     1,
+			Percentile: 60,
+			Default:    tt.suggested,
+		})
+		price, err := dex.APIBackend.SuggestPrice(context.Background())
+		if err != nil {
+			t.Fatalf("test %d: failed to suggest price: %v", i, err)
+		}
+		if price.Cmp(tt.want) != 0 {
+			t.Errorf("test %d: price mismatch: have %v, want %v", i, price, tt.want)
+		}
+	}
+}
+
+type testGasPriceGovernance struct {
+	prices      map[uint64]*big.Int
+	heights     map[uint64]uint64
+	roundLength uint64
+}
+
+func (g *testGasPriceGovernance) MinGasPrice(round uint64) *big.Int {
+	return new(big.Int).Set(g.prices[round])
+}
+
+func (g *testGasPriceGovernance) GetRoundHeight(round uint64) uint64 {
+	return g.heights[round]
+}
+
+func (g *testGasPriceGovernance) DexconConfiguration(round uint64) *params.DexconConfig {
+	return &params.DexconConfig{RoundLength: g.roundLength}
+}
+
+func TestMinGasPriceLookahead(t *testing.T) {
+	gov := &testGasPriceGovernance{
+		prices: map[uint64]*big.Int{
+			0: big.NewInt(100),
+			1: big.NewInt(200),
+			2: big.NewInt(50),
+		},
+		heights:     map[uint64]uint64{0: 0, 1: 600},
+		roundLength: 600,
+	}
+	tests := []struct {
+		round  uint64
+		height uint64
+		want   int64
+	}{
+		// Round 0 ends at height 600, the next round's price is taken into
+		// account from height 536 on.
+		{0, 0, 100},
+		{0, 535, 100},
+		{0, 536, 200},
+		{0, 599, 200},
+		// A lower price of the next round never lowers the floor.
+		{1, 600, 200},
+		{1, 1136, 200},
+		{1, 1199, 200},
+	}
+	for i, tt := range tests {
+		min := minGasPriceAt(gov, tt.round, tt.height)
+		if min.Cmp(big.NewInt(tt.want)) != 0 {
+			t.Errorf("test %d: round %d height %d: floor mismatch: have %v, want %d",
+				i, tt.round, tt.height, min, tt.want)
+		}
+	}
+}
+
+func newUint64(v uint64) *hexutil.Uint64 {
+	u := hexutil.Uint64(v)
+	return &u
+}
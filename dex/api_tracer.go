@@ -198,6 +198,7 @@ func (api *PrivateDebugAPI) traceChain(ctx context.Context, start, end *types.Bl
 			// Fetch and execute the next block trace tasks
 			for task := range tasks {
 				signer := types.MakeSigner(api.config, task.block.Number())
+				vm.ActivateOracleContracts(api.config, task.block.Number(), task.statedb)
 
 				// Trace all the transactions contained within
 				for i, tx := range task.block.Transactions() {
@@ -451,6 +452,7 @@ func (api *PrivateDebugAPI) traceBlock(ctx context.Context, block *types.Block,
 	if err != nil {
 		return nil, err
 	}
+	vm.ActivateOracleContracts(api.config, block.Number(), statedb)
 	// Execute all the transaction contained within the block concurrently
 	var (
 		signer = types.MakeSigner(api.config, block.Number())
@@ -544,6 +546,7 @@ func (api *PrivateDebugAPI) standardTraceBlockToFile(ctx context.Context, block
 	if err != nil {
 		return nil, err
 	}
+	vm.ActivateOracleContracts(api.config, block.Number(), statedb)
 	// Retrieve the tracing configurations, or use default values
 	var (
 		logConfig vm.LogConfig
@@ -749,10 +752,11 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 	switch tracer := tracer.(type) {
 	case *vm.StructLogger:
 		return &ethapi.ExecutionResult{
-			Gas:         gas,
-			Failed:      failed,
-			ReturnValue: fmt.Sprintf("%x", ret),
-			StructLogs:  ethapi.FormatLogs(tracer.StructLogs()),
+			Gas:          gas,
+			Failed:       failed,
+			ReturnValue:  fmt.Sprintf("%x", ret),
+			StructLogs:   ethapi.FormatLogs(tracer.StructLogs()),
+			OracleFrames: tracer.OracleFrames(),
 		}, nil
 
 	case *tracers.Tracer:
@@ -778,6 +782,7 @@ func (api *PrivateDebugAPI) computeTxEnv(blockHash common.Hash, txIndex int, ree
 	if err != nil {
 		return nil, vm.Context{}, nil, err
 	}
+	vm.ActivateOracleContracts(api.config, block.Number(), statedb)
 	// Recompute transactions up to the target index.
 	signer := types.MakeSigner(api.config, block.Number())
 
@@ -37,6 +37,10 @@ import (
 	"github.com/dexon-foundation/dexon/rlp"
 )
 
+// onDemandTimeout is the longest time a block proposed on demand waits for
+// transactions, the consensus stops if no block is delivered for a minute.
+const onDemandTimeout = 10 * time.Second
+
 // DexconApp implements the DEXON consensus core application interface.
 type DexconApp struct {
 	txPool     *core.TxPool
@@ -113,6 +117,10 @@ func (d *DexconApp) validateGasPrice(txs types.Transactions, round uint64) bool
 
 // PreparePayload is called when consensus core is preparing payload for block.
 func (d *DexconApp) PreparePayload(position coreTypes.Position) (payload []byte, err error) {
+	if d.config.ProposeOnDemand {
+		d.waitPendingTxs(position)
+	}
+
 	// softLimit limits the runtime of inner call to preparePayload.
 	// hardLimit limits the runtime of outer PreparePayload.
 	// If hardLimit is hit, it is possible that no payload is prepared.
@@ -151,6 +159,35 @@ func (d *DexconApp) PreparePayload(position coreTypes.Position) (payload []byte,
 	return
 }
 
+// waitPendingTxs blocks until there are pending transactions to propose, the
+// transaction pool is stopped or onDemandTimeout elapsed, as the consensus
+// expects blocks to be delivered regularly. It returns right away if the DKG
+// of the next round has not succeeded yet, as the DKG only advances with the
+// blocks.
+func (d *DexconApp) waitPendingTxs(position coreTypes.Position) {
+	ch := make(chan core.NewTxsEvent, 1)
+	sub := d.txPool.SubscribeNewTxsEvent(ch)
+	if sub == nil {
+		return
+	}
+	defer sub.Unsubscribe()
+
+	timeout := time.After(onDemandTimeout)
+	for d.gov.IsDKGSuccess(position.Round + 1) {
+		pending, err := d.txPool.Pending()
+		if err != nil || len(pending) > 0 {
+			return
+		}
+		select {
+		case <-ch:
+		case <-timeout:
+			return
+		case <-sub.Err():
+			return
+		}
+	}
+}
+
 func (d *DexconApp) preparePayload(ctx context.Context, position coreTypes.Position) (
 	payload []byte, err error) {
 	d.appMu.RLock()
@@ -168,6 +205,9 @@ func (d *DexconApp) preparePayload(ctx context.Context, position coreTypes.Posit
 	}
 
 	deliveredBlock := d.blockchain.GetBlockByNumber(d.deliveredHeight)
+	if deliveredBlock == nil {
+		return nil, fmt.Errorf("delivered block %d not found", d.deliveredHeight)
+	}
 	state, err := d.blockchain.StateAt(deliveredBlock.Root())
 	if err != nil {
 		return nil, fmt.Errorf("get state by root %v error: %v", deliveredBlock.Root(), err)
@@ -319,6 +359,10 @@ func (d *DexconApp) VerifyBlock(block *coreTypes.Block) coreTypes.BlockVerifySta
 	}
 
 	deliveredBlock := d.blockchain.GetBlockByNumber(d.deliveredHeight)
+	if deliveredBlock == nil {
+		log.Error("Can not get delivered block", "height", d.deliveredHeight)
+		return coreTypes.VerifyInvalidBlock
+	}
 	state, err := d.blockchain.StateAt(deliveredBlock.Root())
 	if err != nil {
 		return coreTypes.VerifyInvalidBlock
@@ -479,6 +523,7 @@ func (d *DexconApp) BlockDelivered(
 // BlockConfirmed is called when a block is confirmed.
 func (d *DexconApp) BlockConfirmed(block coreTypes.Block) {
 	propBlockConfirmLatency.Update(time.Since(block.Timestamp).Nanoseconds() / 1000)
+	propBlockConfirmHeight.Update(int64(block.Position.Height))
 
 	d.appMu.Lock()
 	defer d.appMu.Unlock()
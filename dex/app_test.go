@@ -2346,7 +2346,7 @@ func newDexon(masterKey *ecdsa.PrivateKey, accountNum int) (*Dexon, []*ecdsa.Pri
 	dex.txPool = core.NewTxPool(txPoolConfig, chainConfig, dex.blockchain)
 
 	dex.APIBackend = &DexAPIBackend{dex, nil}
-	dex.governance = NewDexconGovernance(dex.APIBackend, dex.chainConfig, config.PrivateKey)
+	dex.governance = NewDexconGovernance(dex.APIBackend, dex.chainConfig, newLocalSigner(config.PrivateKey))
 	engine.SetGovStateFetcher(dex.governance)
 	dex.app = NewDexconApp(dex.txPool, dex.blockchain, dex.governance, db, &config)
 
@@ -18,10 +18,14 @@
 package dex
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
+	dexCore "github.com/dexon-foundation/dexon-consensus/core"
+	coreEcdsa "github.com/dexon-foundation/dexon-consensus/core/crypto/ecdsa"
 	"github.com/dexon-foundation/dexon-consensus/core/syncer"
+	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
 	"github.com/dexon-foundation/dexon/accounts"
 	"github.com/dexon-foundation/dexon/consensus"
 	"github.com/dexon-foundation/dexon/consensus/dexcon"
@@ -29,6 +33,7 @@ import (
 	"github.com/dexon-foundation/dexon/core/bloombits"
 	"github.com/dexon-foundation/dexon/core/rawdb"
 	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
 	"github.com/dexon-foundation/dexon/dex/downloader"
 	"github.com/dexon-foundation/dexon/eth/filters"
 	"github.com/dexon-foundation/dexon/eth/gasprice"
@@ -58,6 +63,7 @@ type Dexon struct {
 
 	// DB interfaces
 	chainDb ethdb.Database // Block chain database
+	signDb  ethdb.Database // Slashing protection database
 
 	eventMux       *event.TypeMux
 	engine         consensus.Engine
@@ -72,6 +78,9 @@ type Dexon struct {
 	app        *DexconApp
 	governance *DexconGovernance
 	network    *DexconNetwork
+	signer     NodeSigner
+
+	signProtection *SignProtection
 
 	bp *blockProposer
 
@@ -124,7 +133,14 @@ func New(ctx *node.ServiceContext, config *Config) (*Dexon, error) {
 			EVMInterpreter:          config.EVMInterpreter,
 			IsBlockProposer:         config.BlockProposerEnabled,
 		}
-		cacheConfig = &core.CacheConfig{Disabled: config.NoPruning, TrieCleanLimit: config.TrieCleanCache, TrieDirtyLimit: config.TrieDirtyCache, TrieTimeLimit: config.TrieTimeout}
+		cacheConfig = &core.CacheConfig{
+			Disabled:         config.NoPruning,
+			TrieCleanLimit:   config.TrieCleanCache,
+			TrieDirtyLimit:   config.TrieDirtyCache,
+			TrieTimeLimit:    config.TrieTimeout,
+			StatePruneBlocks: config.StatePruneBlocks,
+			StatePruneBloom:  config.StatePruneBloom,
+		}
 	)
 	dex.blockchain, err = core.NewBlockChain(chainDb, cacheConfig, dex.chainConfig, dex.engine, vmConfig, nil)
 
@@ -157,7 +173,24 @@ func New(ctx *node.ServiceContext, config *Config) (*Dexon, error) {
 	dex.APIBackend.gpo = gasprice.NewOracle(dex.APIBackend, gpoParams)
 
 	// Dexcon related objects.
-	dex.governance = NewDexconGovernance(dex.APIBackend, dex.chainConfig, config.PrivateKey)
+	if config.ExternalSigner != "" {
+		signer, err := NewExternalSigner(config.ExternalSigner)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to external signer: %v", err)
+		}
+		log.Info("Using external signer", "endpoint", config.ExternalSigner,
+			"address", crypto.PubkeyToAddress(*signer.PublicKey()))
+		// Notary peers are dialed by their node key, which the p2p
+		// handshake still needs in process.
+		if config.PrivateKey != nil && !bytes.Equal(crypto.FromECDSAPub(signer.PublicKey()),
+			crypto.FromECDSAPub(&config.PrivateKey.PublicKey)) {
+			log.Warn("External signer key differs from the p2p node key, notary peers will not reach the node")
+		}
+		dex.signer = signer
+	} else {
+		dex.signer = newLocalSigner(config.PrivateKey)
+	}
+	dex.governance = NewDexconGovernance(dex.APIBackend, dex.chainConfig, dex.signer)
 	dex.app = NewDexconApp(dex.txPool, dex.blockchain, dex.governance, chainDb, config)
 
 	// Set config fetcher so engine can fetch current system configuration from state.
@@ -179,10 +212,20 @@ func New(ctx *node.ServiceContext, config *Config) (*Dexon, error) {
 	}
 
 	dex.protocolManager = pm
-	dex.network = NewDexconNetwork(pm)
-
-	recovery := NewRecovery(chainConfig.Recovery, config.RecoveryNetworkRPC,
-		dex.governance, config.PrivateKey)
+	dex.signDb, err = ctx.OpenDatabase(SignProtectionDatabase, 16, 16)
+	if err != nil {
+		return nil, err
+	}
+	dex.signProtection = NewSignProtection(dex.signDb)
+	dex.network = NewDexconNetwork(pm, coreTypes.NewNodeID(
+		coreEcdsa.NewPublicKeyFromECDSA(dex.signer.PublicKey())),
+		dex.signProtection)
+
+	var recovery dexCore.Recovery = NewRecovery(chainConfig.Recovery,
+		config.RecoveryNetworkRPC, dex.governance, dex.signer)
+	if config.Recovery != nil {
+		recovery = config.Recovery
+	}
 	watchCat := syncer.NewWatchCat(recovery, dex.governance, 10*time.Second,
 		time.Duration(chainConfig.Recovery.Timeout)*time.Second, log.Root())
 
@@ -252,6 +295,10 @@ func (s *Dexon) Start(srvr *p2p.Server) error {
 	// Start the networking layer and the light server if requested
 	s.protocolManager.Start(srvr, maxPeers)
 
+	if s.config.CorePruneRounds > 0 {
+		go s.pruneCoreBlocksLoop()
+	}
+
 	if s.config.BlockProposerEnabled {
 		go func() {
 			// Since we might be in fast sync mode when started. wait for
@@ -284,6 +331,10 @@ func (s *Dexon) Stop() error {
 		s.indexer.Stop()
 	}
 	s.chainDb.Close()
+	s.signDb.Close()
+	if signer, ok := s.signer.(*ExternalSigner); ok {
+		signer.Close()
+	}
 	close(s.shutdownChan)
 	return nil
 }
@@ -296,6 +347,10 @@ func (s *Dexon) IsProposing() bool {
 	return s.bp.IsProposing()
 }
 
+func (s *Dexon) NotaryInfo() (*NotaryInfo, error) {
+	return s.protocolManager.NotaryInfo()
+}
+
 // CreateDB creates the chain database.
 func CreateDB(ctx *node.ServiceContext, config *Config, name string) (ethdb.Database, error) {
 	db, err := ctx.OpenDatabase(name, config.DatabaseCache, config.DatabaseHandles)
@@ -305,6 +360,9 @@ func CreateDB(ctx *node.ServiceContext, config *Config, name string) (ethdb.Data
 	if db, ok := db.(*ethdb.LDBDatabase); ok {
 		db.Meter("eth/db/chaindata/")
 	}
+	if config.DatabaseFreezer != "" && ctx.Config.DataDir != "" {
+		return rawdb.NewDatabaseWithFreezer(db, ctx.ResolvePath(config.DatabaseFreezer))
+	}
 	return db, nil
 }
 
@@ -314,6 +372,7 @@ func (d *Dexon) TxPool() *core.TxPool              { return d.txPool }
 func (d *Dexon) DexVersion() int                   { return int(d.protocolManager.SubProtocols[0].Version) }
 func (d *Dexon) EventMux() *event.TypeMux          { return d.eventMux }
 func (d *Dexon) Engine() consensus.Engine          { return d.engine }
+func (d *Dexon) Governance() *DexconGovernance     { return d.governance }
 func (d *Dexon) ChainDb() ethdb.Database           { return d.chainDb }
 func (d *Dexon) Downloader() ethapi.Downloader     { return d.protocolManager.downloader }
 func (d *Dexon) NetVersion() uint64                { return d.networkID }
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	dexCore "github.com/dexon-foundation/dexon-consensus/core"
-	coreEcdsa "github.com/dexon-foundation/dexon-consensus/core/crypto/ecdsa"
 	"github.com/dexon-foundation/dexon-consensus/core/syncer"
 	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
 
@@ -19,7 +18,8 @@ import (
 )
 
 var (
-	forceSyncTimeout = 20 * time.Second
+	forceSyncTimeout    = 20 * time.Second
+	signProtectionRetry = 5 * time.Second
 )
 
 type blockProposer struct {
@@ -73,6 +73,10 @@ func (b *blockProposer) Start() error {
 			log.Error("Block proposer stopped, before start running", "err", err)
 			return
 		}
+		if !b.waitSignProtection() {
+			log.Info("Block proposer stopped, before start running")
+			return
+		}
 
 		b.run(c)
 		log.Info("Block proposer successfully stopped")
@@ -86,6 +90,7 @@ func (b *blockProposer) run(c *dexCore.Consensus) {
 	atomic.StoreInt32(&b.proposing, 1)
 	<-b.stopCh
 	log.Debug("Block proposer receive stop signal")
+	c.Stop()
 }
 
 func (b *blockProposer) Stop() {
@@ -102,6 +107,33 @@ func (b *blockProposer) Stop() {
 	log.Info("Block proposer stopped")
 }
 
+// waitSignProtection blocks until the local chain caught up with the latest
+// position recorded in the slashing protection store, so a node started from a
+// stale datadir does not start proposing behind what it signed before. It
+// returns false if the block proposer is stopped or the records can't be read.
+func (b *blockProposer) waitSignProtection() bool {
+	for {
+		latest, err := b.dex.signProtection.Latest()
+		if err != nil {
+			log.Error("Failed to read slashing protection records", "err", err)
+			return false
+		}
+		// The node may have voted on the block following the chain head.
+		number := b.dex.blockchain.CurrentBlock().NumberU64()
+		if latest == nil || number+1 >= latest.Height {
+			return true
+		}
+		log.Warn("Waiting for chain to reach signed position", "number", number,
+			"round", latest.Round, "height", latest.Height)
+
+		select {
+		case <-b.stopCh:
+			return false
+		case <-time.After(signProtectionRetry):
+		}
+	}
+}
+
 func (b *blockProposer) IsCoreSyncing() bool {
 	return atomic.LoadInt32(&b.syncing) == 1
 }
@@ -112,7 +144,7 @@ func (b *blockProposer) IsProposing() bool {
 
 func (b *blockProposer) initConsensus() *dexCore.Consensus {
 	db := db.NewDatabase(b.dex.chainDb)
-	privkey := coreEcdsa.NewPrivateKeyFromECDSA(b.dex.config.PrivateKey)
+	privkey := consensusKey{b.dex.signer}
 	return dexCore.NewConsensus(b.dMoment,
 		b.dex.app, b.dex.governance, db, b.dex.network, privkey, log.Root())
 }
@@ -124,7 +156,7 @@ func (b *blockProposer) syncConsensus() (*dexCore.Consensus, error) {
 	cb := b.dex.blockchain.CurrentBlock()
 
 	db := db.NewDatabase(b.dex.chainDb)
-	privkey := coreEcdsa.NewPrivateKeyFromECDSA(b.dex.config.PrivateKey)
+	privkey := consensusKey{b.dex.signer}
 	consensusSync := syncer.NewConsensus(cb.NumberU64(), b.dMoment, b.dex.app,
 		b.dex.governance, db, b.dex.network, privkey, log.Root())
 
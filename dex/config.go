@@ -25,6 +25,7 @@ import (
 	"runtime"
 	"time"
 
+	dexCore "github.com/dexon-foundation/dexon-consensus/core"
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/core"
 	"github.com/dexon-foundation/dexon/dex/downloader"
@@ -43,6 +44,8 @@ var DefaultConfig = Config{
 	TrieDirtyCache: 256,
 	TrieTimeout:    60 * time.Minute,
 
+	StatePruneBloom: 256,
+
 	TxPool: core.DefaultTxPoolConfig,
 	GPO: gasprice.Config{
 		Blocks:     20,
@@ -77,6 +80,10 @@ type Config struct {
 	// PrivateKey, also represents the node identity.
 	PrivateKey *ecdsa.PrivateKey `toml:",omitempty"`
 
+	// ExternalSigner is the endpoint of an external signer holding the node
+	// key the consensus messages and governance transactions are signed with.
+	ExternalSigner string `toml:",omitempty"`
+
 	// Protocol options
 	NetworkId uint64 // Network ID to use for selecting peers to connect to
 	SyncMode  downloader.SyncMode
@@ -94,10 +101,19 @@ type Config struct {
 	DatabaseHandles    int  `toml:"-"`
 	DatabaseCache      int
 	DatabaseDir        string
+	DatabaseFreezer    string `toml:",omitempty"` // Ancient store directory, disabled if empty
 	TrieCleanCache     int
 	TrieDirtyCache     int
 	TrieTimeout        time.Duration
 
+	// Number of recent rounds of finalized core blocks to keep, 0 keeps all.
+	CorePruneRounds uint64
+
+	// Number of recent block states kept by online state pruning, 0 disables
+	// it. The bloom filter size is in megabytes.
+	StatePruneBlocks uint64
+	StatePruneBloom  uint64
+
 	// For calculate gas limit
 	DefaultGasPrice *big.Int
 
@@ -110,6 +126,10 @@ type Config struct {
 	// BlockProposer options
 	BlockProposerEnabled bool
 
+	// Propose blocks only if transactions are pending or the next round is
+	// not prepared yet, used by the developer mode.
+	ProposeOnDemand bool
+
 	// Enables tracking of SHA3 preimages in the VM
 	EnablePreimageRecording bool
 
@@ -133,4 +153,8 @@ type Config struct {
 
 	// Recovery network RPC
 	RecoveryNetworkRPC string
+
+	// Recovery replaces the recovery network if set, used by the developer
+	// mode whose notaries all run in the same process.
+	Recovery dexCore.Recovery `toml:"-"`
 }
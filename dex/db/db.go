@@ -50,7 +50,7 @@ func (d *DB) GetBlock(hash coreCommon.Hash) (coreTypes.Block, error) {
 }
 
 func (d *DB) GetAllBlocks() (coreDb.BlockIterator, error) {
-	return nil, coreDb.ErrNotImplemented
+	return &blockIterator{it: rawdb.NewCoreBlockIterator(d.db)}, nil
 }
 
 func (d *DB) UpdateBlock(block coreTypes.Block) error {
@@ -115,4 +115,53 @@ func (d *DB) GetDKGProtocol() (
 	return *dkgProtocol, nil
 }
 
+// PruneBlocks deletes the core blocks of rounds before the given round which
+// are already finalized into the compaction chain, and returns the number of
+// deleted blocks.
+func (d *DB) PruneBlocks(round uint64) (int, error) {
+	_, tipHeight := d.GetCompactionChainTipInfo()
+
+	it := rawdb.NewCoreBlockIterator(d.db)
+	defer it.Release()
+
+	batch := d.db.NewBatch()
+	pruned := 0
+	for block := it.Next(); block != nil; block = it.Next() {
+		if block.Position.Round >= round || !block.IsFinalized() ||
+			block.Position.Height > tipHeight {
+			continue
+		}
+		rawdb.DeleteCoreBlock(batch, common.Hash(block.Hash))
+		pruned++
+
+		if batch.ValueSize() >= ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return pruned, err
+			}
+			batch.Reset()
+		}
+	}
+	if err := it.Error(); err != nil {
+		return pruned, err
+	}
+	return pruned, batch.Write()
+}
+
 func (d *DB) Close() error { return nil }
+
+// blockIterator implements dexon-consensus BlockIterator interface.
+type blockIterator struct {
+	it *rawdb.CoreBlockIterator
+}
+
+func (b *blockIterator) NextBlock() (coreTypes.Block, error) {
+	block := b.it.Next()
+	if block == nil {
+		defer b.it.Release()
+		if err := b.it.Error(); err != nil {
+			return coreTypes.Block{}, err
+		}
+		return coreTypes.Block{}, coreDb.ErrIterationFinished
+	}
+	return *block, nil
+}
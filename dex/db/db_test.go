@@ -0,0 +1,118 @@
+// Copyright 2018 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"testing"
+
+	coreCommon "github.com/dexon-foundation/dexon-consensus/common"
+	coreDKG "github.com/dexon-foundation/dexon-consensus/core/crypto/dkg"
+	coreDb "github.com/dexon-foundation/dexon-consensus/core/db"
+	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
+
+	"github.com/dexon-foundation/dexon/ethdb"
+)
+
+func newTestBlock(round, height uint64, finalized bool) coreTypes.Block {
+	block := coreTypes.Block{
+		Hash: coreCommon.NewRandomHash(),
+		Position: coreTypes.Position{
+			Round:  round,
+			Height: height,
+		},
+	}
+	if finalized {
+		block.Randomness = []byte{1}
+	}
+	return block
+}
+
+func TestGetAllBlocks(t *testing.T) {
+	db := NewDatabase(ethdb.NewMemDatabase())
+
+	// DKG private keys share the core block key prefix and must be skipped.
+	if err := db.PutDKGPrivateKey(1, 0, *coreDKG.NewPrivateKey()); err != nil {
+		t.Fatalf("put dkg private key failed: %v", err)
+	}
+	blocks := map[coreCommon.Hash]struct{}{}
+	for i := uint64(0); i < 10; i++ {
+		block := newTestBlock(i/3, i, true)
+		if err := db.PutBlock(block); err != nil {
+			t.Fatalf("put block failed: %v", err)
+		}
+		blocks[block.Hash] = struct{}{}
+	}
+
+	it, err := db.GetAllBlocks()
+	if err != nil {
+		t.Fatalf("get all blocks failed: %v", err)
+	}
+	for {
+		block, err := it.NextBlock()
+		if err == coreDb.ErrIterationFinished {
+			break
+		}
+		if err != nil {
+			t.Fatalf("iterate blocks failed: %v", err)
+		}
+		if _, exist := blocks[block.Hash]; !exist {
+			t.Fatalf("unexpected block %s", block.Hash)
+		}
+		delete(blocks, block.Hash)
+	}
+	if len(blocks) != 0 {
+		t.Fatalf("%d blocks are not iterated", len(blocks))
+	}
+}
+
+func TestPruneBlocks(t *testing.T) {
+	db := NewDatabase(ethdb.NewMemDatabase())
+
+	var (
+		finalized   = newTestBlock(0, 1, true)
+		unfinalized = newTestBlock(0, 2, false)
+		undelivered = newTestBlock(1, 5, true)
+		recent      = newTestBlock(2, 3, true)
+	)
+	for _, block := range []coreTypes.Block{
+		finalized, unfinalized, undelivered, recent} {
+		if err := db.PutBlock(block); err != nil {
+			t.Fatalf("put block failed: %v", err)
+		}
+	}
+	if err := db.PutCompactionChainTipInfo(recent.Hash, 3); err != nil {
+		t.Fatalf("put compaction chain tip failed: %v", err)
+	}
+
+	pruned, err := db.PruneBlocks(2)
+	if err != nil {
+		t.Fatalf("prune blocks failed: %v", err)
+	}
+	if pruned != 1 {
+		t.Fatalf("pruned block count mismatch: got %d, want 1", pruned)
+	}
+	if db.HasBlock(finalized.Hash) {
+		t.Errorf("finalized block of old round is not pruned")
+	}
+	for _, block := range []coreTypes.Block{unfinalized, undelivered, recent} {
+		if !db.HasBlock(block.Hash) {
+			t.Errorf("block %s at %s should not be pruned", block.Hash,
+				block.Position)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package dex
+
+import (
+	"errors"
+	"sync"
+
+	coreCommon "github.com/dexon-foundation/dexon-consensus/common"
+	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
+)
+
+// doubleSignWindow is the number of heights below the highest signed one the
+// double sign guard keeps track of.
+const doubleSignWindow = 128
+
+var (
+	errForkVote     = errors.New("refuse to sign forked vote")
+	errForkBlock    = errors.New("refuse to sign forked block")
+	errSignedBefore = errors.New("refuse to sign position signed before restart")
+)
+
+// signedVoteKey identifies the votes the governance contract compares when
+// reporting forked votes.
+type signedVoteKey struct {
+	Type     coreTypes.VoteType
+	Period   uint64
+	Position coreTypes.Position
+}
+
+// doubleSignGuard keeps track of the votes and blocks signed by the node and
+// refuses to release a vote or block conflicting with one released earlier at
+// the same position, which would be fined as a fork. Positions signed before
+// the node was started, as persisted in the slashing protection store, are
+// never signed again.
+//
+// The consensus core signs a vote or block before handing it to the network,
+// and the signer only sees its hash, so the guard runs when the vote or block
+// is broadcast: a conflicting one is signed but never leaves the node. Votes
+// and blocks are the only messages the governance contract fines as forks.
+// Finalized blocks are relayed as they are, agreement results only carry the
+// votes of other nodes, and DKG private shares and partial signatures are not
+// checked.
+type doubleSignGuard struct {
+	id    coreTypes.NodeID
+	store *SignProtection
+	marks map[uint64]*SignRecord // Records of the store when the node started
+
+	votes  map[signedVoteKey]coreCommon.Hash
+	blocks map[coreTypes.Position]coreCommon.Hash
+	tip    uint64 // Highest height signed
+
+	lock sync.Mutex
+}
+
+func newDoubleSignGuard(id coreTypes.NodeID, store *SignProtection) *doubleSignGuard {
+	return &doubleSignGuard{
+		id:     id,
+		store:  store,
+		marks:  make(map[uint64]*SignRecord),
+		votes:  make(map[signedVoteKey]coreCommon.Hash),
+		blocks: make(map[coreTypes.Position]coreCommon.Hash),
+	}
+}
+
+// checkVote records a vote of the node, returning an error if it conflicts
+// with a vote signed earlier. Votes of other nodes are ignored.
+func (g *doubleSignGuard) checkVote(vote *coreTypes.Vote) error {
+	if vote.ProposerID != g.id {
+		return nil
+	}
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	key := signedVoteKey{Type: vote.Type, Period: vote.Period, Position: vote.Position}
+	if hash, ok := g.votes[key]; ok {
+		if hash != vote.BlockHash {
+			return errForkVote
+		}
+		return nil
+	}
+	mark, err := g.mark(vote.Position.Round)
+	if err != nil {
+		return err
+	}
+	if mark != nil && mark.below(vote.Position.Height, vote.Period) {
+		return errSignedBefore
+	}
+	if err := g.store.Record(vote.Position.Round, vote.Position.Height, vote.Period); err != nil {
+		return err
+	}
+	g.votes[key] = vote.BlockHash
+	g.advance(vote.Position.Height)
+	return nil
+}
+
+// checkBlock records a block proposed by the node, returning an error if it
+// conflicts with a block signed earlier. Blocks of other nodes are ignored.
+func (g *doubleSignGuard) checkBlock(block *coreTypes.Block) error {
+	if block.ProposerID != g.id {
+		return nil
+	}
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if hash, ok := g.blocks[block.Position]; ok {
+		if hash != block.Hash {
+			return errForkBlock
+		}
+		return nil
+	}
+	mark, err := g.mark(block.Position.Round)
+	if err != nil {
+		return err
+	}
+	if mark != nil && block.Position.Height <= mark.Height {
+		return errSignedBefore
+	}
+	if err := g.store.Record(block.Position.Round, block.Position.Height, 0); err != nil {
+		return err
+	}
+	g.blocks[block.Position] = block.Hash
+	g.advance(block.Position.Height)
+	return nil
+}
+
+// mark returns the record of a round in the slashing protection store as it
+// was before the node signed anything in the round. The lock must be held.
+func (g *doubleSignGuard) mark(round uint64) (*SignRecord, error) {
+	if mark, ok := g.marks[round]; ok {
+		return mark, nil
+	}
+	mark, err := g.store.Get(round)
+	if err != nil {
+		return nil, err
+	}
+	g.marks[round] = mark
+	return mark, nil
+}
+
+// advance moves the highest signed height forward, dropping the records which
+// fell out of the tracking window. The lock must be held.
+func (g *doubleSignGuard) advance(height uint64) {
+	if height <= g.tip {
+		return
+	}
+	g.tip = height
+	if g.tip < doubleSignWindow {
+		return
+	}
+	limit := g.tip - doubleSignWindow
+	for key := range g.votes {
+		if key.Position.Height < limit {
+			delete(g.votes, key)
+		}
+	}
+	for pos := range g.blocks {
+		if pos.Height < limit {
+			delete(g.blocks, pos)
+		}
+	}
+}
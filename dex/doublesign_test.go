@@ -0,0 +1,138 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package dex
+
+import (
+	"testing"
+
+	coreCommon "github.com/dexon-foundation/dexon-consensus/common"
+	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
+
+	"github.com/dexon-foundation/dexon/ethdb"
+)
+
+func TestDoubleSignGuardVote(t *testing.T) {
+	self := coreTypes.NodeID{Hash: coreCommon.NewRandomHash()}
+	other := coreTypes.NodeID{Hash: coreCommon.NewRandomHash()}
+	guard := newDoubleSignGuard(self, NewSignProtection(ethdb.NewMemDatabase()))
+
+	newVote := func(id coreTypes.NodeID, period uint64, height uint64) *coreTypes.Vote {
+		return &coreTypes.Vote{
+			VoteHeader: coreTypes.VoteHeader{
+				ProposerID: id,
+				Type:       coreTypes.VoteCom,
+				BlockHash:  coreCommon.NewRandomHash(),
+				Period:     period,
+				Position:   coreTypes.Position{Height: height},
+			},
+		}
+	}
+	vote := newVote(self, 1, 10)
+	if err := guard.checkVote(vote); err != nil {
+		t.Fatalf("first vote rejected: %v", err)
+	}
+	if err := guard.checkVote(vote); err != nil {
+		t.Errorf("repeated vote rejected: %v", err)
+	}
+	if err := guard.checkVote(newVote(self, 1, 10)); err != errForkVote {
+		t.Errorf("forked vote error mismatch: have %v, want %v", err, errForkVote)
+	}
+	if err := guard.checkVote(newVote(self, 2, 10)); err != nil {
+		t.Errorf("vote of next period rejected: %v", err)
+	}
+	if err := guard.checkVote(newVote(other, 1, 10)); err != nil {
+		t.Errorf("vote of other node rejected: %v", err)
+	}
+	// Records falling out of the window are dropped.
+	if err := guard.checkVote(newVote(self, 1, 10+doubleSignWindow+1)); err != nil {
+		t.Fatalf("vote rejected: %v", err)
+	}
+	if len(guard.votes) != 1 {
+		t.Errorf("tracked votes mismatch: have %d, want 1", len(guard.votes))
+	}
+}
+
+func TestDoubleSignGuardBlock(t *testing.T) {
+	self := coreTypes.NodeID{Hash: coreCommon.NewRandomHash()}
+	guard := newDoubleSignGuard(self, NewSignProtection(ethdb.NewMemDatabase()))
+
+	newBlock := func(height uint64) *coreTypes.Block {
+		return &coreTypes.Block{
+			ProposerID: self,
+			Hash:       coreCommon.NewRandomHash(),
+			Position:   coreTypes.Position{Height: height},
+		}
+	}
+	block := newBlock(10)
+	if err := guard.checkBlock(block); err != nil {
+		t.Fatalf("first block rejected: %v", err)
+	}
+	if err := guard.checkBlock(block); err != nil {
+		t.Errorf("repeated block rejected: %v", err)
+	}
+	if err := guard.checkBlock(newBlock(10)); err != errForkBlock {
+		t.Errorf("forked block error mismatch: have %v, want %v", err, errForkBlock)
+	}
+	if err := guard.checkBlock(newBlock(11)); err != nil {
+		t.Errorf("next block rejected: %v", err)
+	}
+}
+
+func TestDoubleSignGuardRestart(t *testing.T) {
+	self := coreTypes.NodeID{Hash: coreCommon.NewRandomHash()}
+	store := NewSignProtection(ethdb.NewMemDatabase())
+	guard := newDoubleSignGuard(self, store)
+
+	newVote := func(period uint64, height uint64) *coreTypes.Vote {
+		return &coreTypes.Vote{
+			VoteHeader: coreTypes.VoteHeader{
+				ProposerID: self,
+				Type:       coreTypes.VoteCom,
+				BlockHash:  coreCommon.NewRandomHash(),
+				Period:     period,
+				Position:   coreTypes.Position{Round: 1, Height: height},
+			},
+		}
+	}
+	if err := guard.checkVote(newVote(2, 10)); err != nil {
+		t.Fatalf("vote rejected: %v", err)
+	}
+	// A guard created after restart only knows the persisted record.
+	guard = newDoubleSignGuard(self, store)
+	if err := guard.checkVote(newVote(2, 10)); err != errSignedBefore {
+		t.Errorf("signed vote error mismatch: have %v, want %v", err, errSignedBefore)
+	}
+	if err := guard.checkVote(newVote(1, 10)); err != errSignedBefore {
+		t.Errorf("lower period error mismatch: have %v, want %v", err, errSignedBefore)
+	}
+	if err := guard.checkVote(newVote(3, 10)); err != nil {
+		t.Errorf("vote of next period rejected: %v", err)
+	}
+	block := &coreTypes.Block{
+		ProposerID: self,
+		Hash:       coreCommon.NewRandomHash(),
+		Position:   coreTypes.Position{Round: 1, Height: 10},
+	}
+	if err := guard.checkBlock(block); err != errSignedBefore {
+		t.Errorf("signed block error mismatch: have %v, want %v", err, errSignedBefore)
+	}
+	block.Position.Height = 11
+	if err := guard.checkBlock(block); err != nil {
+		t.Errorf("next block rejected: %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/core/rawdb"
 	"github.com/dexon-foundation/dexon/core/state"
 	"github.com/dexon-foundation/dexon/core/types"
 	"github.com/dexon-foundation/dexon/crypto"
@@ -36,6 +37,14 @@ func (g *governanceStateDB) StateAt(height uint64) (*state.StateDB, error) {
 	return state.New(root, state.NewDatabase(g.db))
 }
 
+func (g *governanceStateDB) ReadSnapshot(round uint64) *types.GovernanceSnapshot {
+	return rawdb.ReadGovernanceSnapshot(g.db, round)
+}
+
+func (g *governanceStateDB) WriteSnapshot(snapshot *types.GovernanceSnapshot) {
+	rawdb.WriteGovernanceSnapshot(g.db, snapshot)
+}
+
 func (g *governanceStateDB) StoreState(s *types.GovState) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
@@ -0,0 +1,199 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package dex
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"time"
+
+	coreCommon "github.com/dexon-foundation/dexon-consensus/common"
+	coreCrypto "github.com/dexon-foundation/dexon-consensus/core/crypto"
+	coreEcdsa "github.com/dexon-foundation/dexon-consensus/core/crypto/ecdsa"
+
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/rpc"
+)
+
+// externalSignerTimeout is the time allowed for the external signer to answer
+// a request.
+const externalSignerTimeout = 10 * time.Second
+
+var errSignerMismatch = errors.New("signature not made by the node key")
+
+// NodeSigner signs hashes with the node key, which the consensus core, the
+// governance and the recovery transactions are signed with.
+type NodeSigner interface {
+	// PublicKey returns the public key of the node key.
+	PublicKey() *ecdsa.PublicKey
+
+	// SignHash returns the [R || S || V] signature of a hash.
+	SignHash(hash []byte) ([]byte, error)
+}
+
+// localSigner is a node signer holding the node key in process.
+type localSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func newLocalSigner(key *ecdsa.PrivateKey) *localSigner {
+	return &localSigner{key: key}
+}
+
+func (s *localSigner) PublicKey() *ecdsa.PublicKey {
+	return &s.key.PublicKey
+}
+
+func (s *localSigner) SignHash(hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, s.key)
+}
+
+// ExternalSigner is a node signer delegating to an external signer process.
+// The signer is reached over RPC, usually its IPC endpoint, and serves the
+// notary API of NotarySignerAPI.
+//
+// The consensus votes, blocks and DKG messages as well as the governance and
+// recovery transactions are signed through it. The p2p handshake and the
+// node discovery still use the p2p node key in process, and notary peers dial
+// a notary by the node ID derived from its consensus key, so the p2p node key
+// has to be the key of the signer for the node to be reached as a notary.
+type ExternalSigner struct {
+	client *rpc.Client
+	pubkey *ecdsa.PublicKey
+}
+
+// NewExternalSigner connects to the external signer at the given endpoint and
+// retrieves the public key of the node key it holds.
+func NewExternalSigner(endpoint string) (*ExternalSigner, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := newExternalSigner(client)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	return signer, nil
+}
+
+func newExternalSigner(client *rpc.Client) (*ExternalSigner, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), externalSignerTimeout)
+	defer cancel()
+
+	var blob hexutil.Bytes
+	if err := client.CallContext(ctx, &blob, "notary_publicKey"); err != nil {
+		return nil, fmt.Errorf("failed to retrieve node public key: %v", err)
+	}
+	pubkey, err := crypto.UnmarshalPubkey(blob)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node public key: %v", err)
+	}
+	return &ExternalSigner{client: client, pubkey: pubkey}, nil
+}
+
+// PublicKey returns the public key of the node key held by the signer.
+func (s *ExternalSigner) PublicKey() *ecdsa.PublicKey {
+	return s.pubkey
+}
+
+// SignHash requests the signature of a hash from the signer. The signature is
+// checked against the node public key, so a signer holding another key is
+// caught before anything is signed with it.
+func (s *ExternalSigner) SignHash(hash []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), externalSignerTimeout)
+	defer cancel()
+
+	var sig hexutil.Bytes
+	if err := s.client.CallContext(ctx, &sig, "notary_signHash", hexutil.Bytes(hash)); err != nil {
+		return nil, err
+	}
+	if len(sig) != 65 {
+		return nil, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+	pubkey, err := crypto.Ecrecover(hash, sig)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(pubkey, crypto.FromECDSAPub(s.pubkey)) {
+		return nil, errSignerMismatch
+	}
+	return sig, nil
+}
+
+// Close disconnects from the signer.
+func (s *ExternalSigner) Close() {
+	s.client.Close()
+}
+
+// NotarySignerAPI is the notary API an external signer process serves to the
+// node for the node key it holds.
+type NotarySignerAPI struct {
+	key *ecdsa.PrivateKey
+}
+
+// NewNotarySignerAPI creates the notary API signing with the node key.
+func NewNotarySignerAPI(key *ecdsa.PrivateKey) *NotarySignerAPI {
+	return &NotarySignerAPI{key: key}
+}
+
+// PublicKey returns the uncompressed public key of the node key.
+func (api *NotarySignerAPI) PublicKey() hexutil.Bytes {
+	return crypto.FromECDSAPub(&api.key.PublicKey)
+}
+
+// SignHash signs a hash with the node key.
+func (api *NotarySignerAPI) SignHash(hash hexutil.Bytes) (hexutil.Bytes, error) {
+	if len(hash) != coreCommon.HashLength {
+		return nil, fmt.Errorf("invalid hash length %d", len(hash))
+	}
+	return crypto.Sign(hash, api.key)
+}
+
+// consensusKey adapts a node signer to the private key of the consensus core,
+// which signs the votes, blocks and DKG messages of the node.
+type consensusKey struct {
+	signer NodeSigner
+}
+
+func (k consensusKey) PublicKey() coreCrypto.PublicKey {
+	return coreEcdsa.NewPublicKeyFromECDSA(k.signer.PublicKey())
+}
+
+func (k consensusKey) Sign(hash coreCommon.Hash) (coreCrypto.Signature, error) {
+	sig, err := k.signer.SignHash(hash[:])
+	if err != nil {
+		return coreCrypto.Signature{}, err
+	}
+	return coreCrypto.Signature{Type: "ecdsa", Signature: sig}, nil
+}
+
+// signTx signs a transaction with the node signer.
+func signTx(tx *types.Transaction, s types.Signer, signer NodeSigner) (*types.Transaction, error) {
+	h := s.Hash(tx)
+	sig, err := signer.SignHash(h[:])
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(s, sig)
+}
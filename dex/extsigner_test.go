@@ -0,0 +1,105 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package dex
+
+import (
+	"math/big"
+	"testing"
+
+	coreCommon "github.com/dexon-foundation/dexon-consensus/common"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/rpc"
+)
+
+func newTestExternalSigner(t *testing.T, service interface{}) *ExternalSigner {
+	server := rpc.NewServer()
+	if err := server.RegisterName("notary", service); err != nil {
+		t.Fatalf("failed to register notary API: %v", err)
+	}
+	signer, err := newExternalSigner(rpc.DialInProc(server))
+	if err != nil {
+		t.Fatalf("failed to connect to signer: %v", err)
+	}
+	return signer
+}
+
+func TestExternalSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	signer := newTestExternalSigner(t, NewNotarySignerAPI(key))
+	defer signer.Close()
+
+	if crypto.PubkeyToAddress(*signer.PublicKey()) != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("public key mismatch")
+	}
+
+	// Consensus messages are signed by the key of the signer.
+	prv := consensusKey{signer}
+	hash := coreCommon.NewRandomHash()
+	sig, err := prv.Sign(hash)
+	if err != nil {
+		t.Fatalf("failed to sign hash: %v", err)
+	}
+	if !prv.PublicKey().VerifySignature(hash, sig) {
+		t.Errorf("consensus signature rejected")
+	}
+
+	// So are governance transactions.
+	s := types.NewEIP155Signer(big.NewInt(237))
+	tx := types.NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil)
+	tx, err = signTx(tx, s, signer)
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+	from, err := types.Sender(s, tx)
+	if err != nil {
+		t.Fatalf("failed to recover sender: %v", err)
+	}
+	if from != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Errorf("sender mismatch: have %x, want %x", from, crypto.PubkeyToAddress(key.PublicKey))
+	}
+
+	if _, err := signer.SignHash([]byte{1, 2, 3}); err == nil {
+		t.Errorf("signed a malformed hash")
+	}
+}
+
+func TestExternalSignerKeyMismatch(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	other, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	// The signer holds another key than the one the node was configured with.
+	signer := newTestExternalSigner(t, NewNotarySignerAPI(other))
+	defer signer.Close()
+	signer.pubkey = &key.PublicKey
+
+	hash := coreCommon.NewRandomHash()
+	if _, err := signer.SignHash(hash[:]); err != errSignerMismatch {
+		t.Errorf("error mismatch: have %v, want %v", err, errSignerMismatch)
+	}
+}
@@ -19,7 +19,7 @@ package dex
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"encoding/hex"
 	"math/big"
 
 	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
@@ -39,21 +39,21 @@ type DexconGovernance struct {
 
 	b           *DexAPIBackend
 	chainConfig *params.ChainConfig
-	privateKey  *ecdsa.PrivateKey
+	signer      NodeSigner
 	address     common.Address
 }
 
 // NewDexconGovernance returns a governance implementation of the DEXON
 // consensus governance interface.
 func NewDexconGovernance(backend *DexAPIBackend, chainConfig *params.ChainConfig,
-	privKey *ecdsa.PrivateKey) *DexconGovernance {
+	signer NodeSigner) *DexconGovernance {
 	g := &DexconGovernance{
 		Governance: core.NewGovernance(
 			core.NewGovernanceStateDB(backend.dex.BlockChain())),
 		b:           backend,
 		chainConfig: chainConfig,
-		privateKey:  privKey,
-		address:     crypto.PubkeyToAddress(privKey.PublicKey),
+		signer:      signer,
+		address:     crypto.PubkeyToAddress(*signer.PublicKey()),
 	}
 	return g
 }
@@ -93,7 +93,7 @@ func (d *DexconGovernance) sendGovTx(ctx context.Context, data []byte) error {
 
 	signer := types.NewEIP155Signer(d.chainConfig.ChainID)
 
-	tx, err = types.SignTx(tx, signer, d.privateKey)
+	tx, err = signTx(tx, signer, d.signer)
 	if err != nil {
 		return err
 	}
@@ -107,6 +107,17 @@ func (d *DexconGovernance) Round() uint64 {
 	return d.b.CurrentBlock().Round()
 }
 
+// IsNotary returns whether the node key of this node is in the notary set of
+// the given round.
+func (d *DexconGovernance) IsNotary(round uint64) (bool, error) {
+	notarySet, err := d.NotarySet(round)
+	if err != nil {
+		return false, err
+	}
+	_, ok := notarySet[hex.EncodeToString(crypto.FromECDSAPub(d.signer.PublicKey()))]
+	return ok, nil
+}
+
 // ProposeCRS send proposals of a new CRS
 func (d *DexconGovernance) ProposeCRS(round uint64, signedCRS []byte) {
 	data, err := vm.PackProposeCRS(round, signedCRS)
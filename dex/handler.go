@@ -1395,13 +1395,15 @@ type NotaryInfo struct {
 	Round        uint64            `json:"round"`
 	IsNotary     bool              `json:"is_notary"`
 	Nodes        []*NotaryNodeInfo `json:"nodes"`
+	NextRound    uint64            `json:"next_round"`
 	IsNextNotary bool              `json:"is_next_notary"`
 	Next         []*NotaryNodeInfo `json:"next"`
 }
 
 type NotaryNodeInfo struct {
-	ID     enode.ID `json:"id"`
-	Number uint64   `json:"number"`
+	ID        enode.ID `json:"id"`
+	Number    uint64   `json:"number"`
+	Connected bool     `json:"connected"`
 }
 
 func (pm *ProtocolManager) NotaryInfo() (*NotaryInfo, error) {
@@ -1433,6 +1435,7 @@ func (pm *ProtocolManager) NotaryInfo() (*NotaryInfo, error) {
 		if err != nil {
 			return nil, err
 		}
+		info.NextRound = crsRound
 		info.Next = nextNodes
 		info.IsNextNotary = in
 	}
@@ -1460,6 +1463,7 @@ func (pm *ProtocolManager) buildNotaryNodeInfo(
 		if p := pm.peers.Peer(n.ID.String()); p != nil {
 			_, number := p.Head()
 			n.Number = number
+			n.Connected = true
 		}
 		if n.ID == pm.srvr.Self().ID() {
 			n.Number = pm.blockchain.CurrentBlock().NumberU64()
@@ -23,6 +23,7 @@ import (
 
 var (
 	propBlockConfirmLatency                = metrics.NewRegisteredGauge("dex/prop/blockconfirm/latency", nil)
+	propBlockConfirmHeight                 = metrics.NewRegisteredGauge("dex/prop/blockconfirm/height", nil)
 	propTxnInPacketsMeter                  = metrics.NewRegisteredMeter("dex/prop/txns/in/packets", nil)
 	propTxnInTrafficMeter                  = metrics.NewRegisteredMeter("dex/prop/txns/in/traffic", nil)
 	propTxnOutPacketsMeter                 = metrics.NewRegisteredMeter("dex/prop/txns/out/packets", nil)
@@ -22,14 +22,19 @@ import (
 	"github.com/dexon-foundation/dexon-consensus/core/crypto"
 	"github.com/dexon-foundation/dexon-consensus/core/types"
 	dkgTypes "github.com/dexon-foundation/dexon-consensus/core/types/dkg"
+
+	"github.com/dexon-foundation/dexon/log"
 )
 
+// DexconNetwork implements the network interface of the consensus core. Votes
+// and blocks of the node pass its double sign guard before being broadcast.
 type DexconNetwork struct {
-	pm *ProtocolManager
+	pm    *ProtocolManager
+	guard *doubleSignGuard
 }
 
-func NewDexconNetwork(pm *ProtocolManager) *DexconNetwork {
-	return &DexconNetwork{pm: pm}
+func NewDexconNetwork(pm *ProtocolManager, id types.NodeID, store *SignProtection) *DexconNetwork {
+	return &DexconNetwork{pm: pm, guard: newDoubleSignGuard(id, store)}
 }
 
 // PullBlocks tries to pull blocks from the DEXON network.
@@ -47,6 +52,10 @@ func (n *DexconNetwork) PullVotes(pos types.Position) {
 
 // BroadcastVote broadcasts vote to all nodes in DEXON network.
 func (n *DexconNetwork) BroadcastVote(vote *types.Vote) {
+	if err := n.guard.checkVote(vote); err != nil {
+		log.Error("Dropped conflicting vote", "vote", vote, "err", err)
+		return
+	}
 	n.pm.BroadcastVote(vote)
 }
 
@@ -55,6 +64,10 @@ func (n *DexconNetwork) BroadcastBlock(block *types.Block) {
 	if block.IsFinalized() {
 		n.pm.BroadcastFinalizedBlock(block)
 	} else {
+		if err := n.guard.checkBlock(block); err != nil {
+			log.Error("Dropped conflicting block", "block", block, "err", err)
+			return
+		}
 		n.pm.BroadcastCoreBlock(block)
 	}
 }
@@ -78,7 +91,8 @@ func (n *DexconNetwork) BroadcastDKGPartialSignature(
 	n.pm.BroadcastDKGPartialSignature(psig)
 }
 
-// BroadcastAgreementResult broadcasts rand request to DKG set.
+// BroadcastAgreementResult broadcasts rand request to DKG set. The result
+// carries no signature of the node, it is not checked by the guard.
 func (n *DexconNetwork) BroadcastAgreementResult(result *types.AgreementResult) {
 	n.pm.BroadcastAgreementResult(result)
 }
@@ -0,0 +1,66 @@
+// Copyright 2018 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package dex
+
+import (
+	"time"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/dex/db"
+	"github.com/dexon-foundation/dexon/log"
+)
+
+// pruneCoreBlocksLoop deletes finalized core blocks older than the configured
+// number of rounds whenever the chain enters a new round.
+func (s *Dexon) pruneCoreBlocksLoop() {
+	ch := make(chan core.ChainHeadEvent, 16)
+	sub := s.blockchain.SubscribeChainHeadEvent(ch)
+	defer sub.Unsubscribe()
+
+	var (
+		coreDB    = db.NewDatabase(s.chainDb)
+		keep      = s.config.CorePruneRounds
+		lastRound = s.blockchain.CurrentBlock().Round()
+	)
+	for {
+		select {
+		case ev := <-ch:
+			round := ev.Block.Round()
+			if round <= lastRound {
+				continue
+			}
+			lastRound = round
+			if round <= keep {
+				continue
+			}
+			start := time.Now()
+			pruned, err := coreDB.PruneBlocks(round - keep)
+			if err != nil {
+				log.Error("Failed to prune core blocks", "round", round, "err", err)
+				continue
+			}
+			log.Info("Pruned core blocks", "before", round-keep, "count", pruned,
+				"elapsed", common.PrettyDuration(time.Since(start)))
+		case <-sub.Err():
+			return
+		case <-s.shutdownChan:
+			return
+		}
+	}
+}
@@ -18,7 +18,6 @@
 package dex
 
 import (
-	"crypto/ecdsa"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -261,21 +260,21 @@ type Recovery struct {
 	contract     common.Address
 	confirmation int
 	publicKey    string
-	privateKey   *ecdsa.PrivateKey
+	signer       NodeSigner
 	nodeAddress  common.Address
 	client       *ethrpc.EthRPC
 }
 
 func NewRecovery(config *params.RecoveryConfig, networkRPC string,
-	gov *DexconGovernance, privKey *ecdsa.PrivateKey) *Recovery {
+	gov *DexconGovernance, signer NodeSigner) *Recovery {
 	client := ethrpc.New(networkRPC)
 	return &Recovery{
 		gov:          gov,
 		contract:     config.Contract,
 		confirmation: config.Confirmation,
-		publicKey:    hex.EncodeToString(crypto.FromECDSAPub(&privKey.PublicKey)),
-		privateKey:   privKey,
-		nodeAddress:  crypto.PubkeyToAddress(privKey.PublicKey),
+		publicKey:    hex.EncodeToString(crypto.FromECDSAPub(signer.PublicKey())),
+		signer:       signer,
+		nodeAddress:  crypto.PubkeyToAddress(*signer.PublicKey()),
 		client:       client,
 	}
 }
@@ -373,7 +372,7 @@ func (r *Recovery) genVoteForSkipBlockTx(height uint64) (*types.Transaction, err
 		data)
 
 	signer := types.NewEIP155Signer(big.NewInt(int64(networkID)))
-	return types.SignTx(tx, signer, r.privateKey)
+	return signTx(tx, signer, r.signer)
 }
 
 func (r *Recovery) ProposeSkipBlock(height uint64) error {
@@ -35,7 +35,7 @@ func TestRecoveryVoteTxGeneration(t *testing.T) {
 		Contract:     common.HexToAddress("f675c0e9bf4b949f50dcec5b224a70f0361d4680"),
 		Timeout:      30,
 		Confirmation: 1,
-	}, "https://rinkeby.infura.io", nil, key)
+	}, "https://rinkeby.infura.io", nil, newLocalSigner(key))
 	_, err = r.genVoteForSkipBlockTx(0)
 	if err != nil {
 		t.Fatalf("failed to generate voteForSkipBlock tx: %v", err)
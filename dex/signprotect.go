@@ -0,0 +1,219 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package dex
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/dexon-foundation/dexon/ethdb"
+	"github.com/dexon-foundation/dexon/rlp"
+)
+
+// SignProtectionDatabase is the name of the database, separate from the chain
+// database, the slashing protection records are stored in.
+const SignProtectionDatabase = "signprotection"
+
+// signRecordPrefix prefixes the big endian round of the records in the database.
+var signRecordPrefix = []byte("signed-")
+
+// SignRecord is the highest position and period a node signed a vote or block
+// at in a round.
+type SignRecord struct {
+	Round  uint64 `json:"round"`
+	Height uint64 `json:"height"`
+	Period uint64 `json:"period"`
+}
+
+// below returns whether the given height and period is not above the record.
+func (r *SignRecord) below(height, period uint64) bool {
+	return height < r.Height || (height == r.Height && period <= r.Period)
+}
+
+// SignProtection is a slashing protection store persisting the highest
+// position signed by the node in each round, so a node restarted from a stale
+// datadir or moved to another machine does not sign a conflicting vote or
+// block again.
+type SignProtection struct {
+	db      ethdb.Database
+	records map[uint64]*SignRecord // Cache of the records read or written
+	latest  *SignRecord            // Record of the highest round, loaded once
+
+	latestLoaded bool
+	lock         sync.Mutex
+}
+
+// NewSignProtection creates a slashing protection store on top of db.
+func NewSignProtection(db ethdb.Database) *SignProtection {
+	return &SignProtection{
+		db:      db,
+		records: make(map[uint64]*SignRecord),
+	}
+}
+
+func signRecordKey(round uint64) []byte {
+	key := make([]byte, len(signRecordPrefix)+8)
+	copy(key, signRecordPrefix)
+	binary.BigEndian.PutUint64(key[len(signRecordPrefix):], round)
+	return key
+}
+
+// Get returns the record of a round, nil if nothing was signed in it.
+func (s *SignProtection) Get(round uint64) (*SignRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	record, err := s.get(round)
+	if record == nil || err != nil {
+		return nil, err
+	}
+	cpy := *record
+	return &cpy, nil
+}
+
+// get returns the cached record of a round, reading it from the database if
+// not cached yet. The lock must be held.
+func (s *SignProtection) get(round uint64) (*SignRecord, error) {
+	if record, ok := s.records[round]; ok {
+		return record, nil
+	}
+	blob, _ := s.db.Get(signRecordKey(round))
+	if len(blob) == 0 {
+		return nil, nil
+	}
+	record := new(SignRecord)
+	if err := rlp.DecodeBytes(blob, record); err != nil {
+		return nil, err
+	}
+	s.records[round] = record
+	return record, nil
+}
+
+// Record raises the record of a round to the given height and period if it is
+// above the recorded one. The record is synced to disk before returning, so
+// it survives a crash right after the vote or block is released.
+func (s *SignProtection) Record(round, height, period uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	db, ok := s.db.(ethdb.SyncPutter)
+	if !ok {
+		return errors.New("slashing protection database cannot sync writes")
+	}
+	record, err := s.get(round)
+	if err != nil {
+		return err
+	}
+	if record != nil && record.below(height, period) {
+		return nil
+	}
+	record = &SignRecord{Round: round, Height: height, Period: period}
+	blob, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return err
+	}
+	if err := db.PutSync(signRecordKey(round), blob); err != nil {
+		return err
+	}
+	s.records[round] = record
+	if s.latestLoaded && (s.latest == nil || s.latest.Round <= round) {
+		s.latest = record
+	}
+	return nil
+}
+
+// Records returns all records ordered by round.
+func (s *SignProtection) Records() ([]*SignRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	it := s.db.NewIteratorWithPrefix(signRecordPrefix)
+	defer it.Release()
+
+	var records []*SignRecord
+	for it.Next() {
+		record := new(SignRecord)
+		if err := rlp.DecodeBytes(it.Value(), record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, it.Error()
+}
+
+// Latest returns the record of the highest round, nil if nothing was signed.
+func (s *SignProtection) Latest() (*SignRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.latestLoaded {
+		// Rounds are keyed big endian, the last record is the highest one.
+		it := s.db.NewIteratorWithPrefix(signRecordPrefix)
+		var latest *SignRecord
+		for it.Next() {
+			latest = new(SignRecord)
+			if err := rlp.DecodeBytes(it.Value(), latest); err != nil {
+				it.Release()
+				return nil, err
+			}
+		}
+		err := it.Error()
+		it.Release()
+		if err != nil {
+			return nil, err
+		}
+		s.latest, s.latestLoaded = latest, true
+	}
+	if s.latest == nil {
+		return nil, nil
+	}
+	cpy := *s.latest
+	return &cpy, nil
+}
+
+// Export writes all records as JSON into w.
+func (s *SignProtection) Export(w io.Writer) error {
+	records, err := s.Records()
+	if err != nil {
+		return err
+	}
+	if records == nil {
+		records = []*SignRecord{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
+
+// Import merges the JSON records read from r into the store, keeping the
+// higher record of each round.
+func (s *SignProtection) Import(r io.Reader) error {
+	var records []*SignRecord
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err := s.Record(record.Round, record.Height, record.Period); err != nil {
+			return err
+		}
+	}
+	return nil
+}
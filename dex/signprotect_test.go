@@ -0,0 +1,117 @@
+// Copyright 2019 The dexon-consensus Authors
+// This file is part of the dexon-consensus library.
+//
+// The dexon-consensus library is free software: you can redistribute it
+// and/or modify it under the terms of the GNU Lesser General Public License as
+// published by the Free Software Foundation, either version 3 of the License,
+// or (at your option) any later version.
+//
+// The dexon-consensus library is distributed in the hope that it will be
+// useful, but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU Lesser
+// General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the dexon-consensus library. If not, see
+// <http://www.gnu.org/licenses/>.
+
+package dex
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/ethdb"
+)
+
+func TestSignProtectionRecord(t *testing.T) {
+	store := NewSignProtection(ethdb.NewMemDatabase())
+	if latest, err := store.Latest(); latest != nil || err != nil {
+		t.Fatalf("empty store latest mismatch: have %v, %v", latest, err)
+	}
+	store.Record(1, 10, 2)
+	store.Record(1, 10, 1) // lower period, ignored
+	store.Record(1, 9, 5)  // lower height, ignored
+	store.Record(0, 5, 0)
+
+	want := &SignRecord{Round: 1, Height: 10, Period: 2}
+	if record, _ := store.Get(1); !reflect.DeepEqual(record, want) {
+		t.Errorf("record mismatch: have %v, want %v", record, want)
+	}
+	if latest, _ := store.Latest(); !reflect.DeepEqual(latest, want) {
+		t.Errorf("latest mismatch: have %v, want %v", latest, want)
+	}
+	store.Record(1, 11, 0)
+	want = &SignRecord{Round: 1, Height: 11, Period: 0}
+	if record, _ := store.Get(1); !reflect.DeepEqual(record, want) {
+		t.Errorf("raised record mismatch: have %v, want %v", record, want)
+	}
+}
+
+func TestSignProtectionExportImport(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	src := NewSignProtection(db)
+	src.Record(0, 5, 1)
+	src.Record(1, 10, 2)
+
+	var buf bytes.Buffer
+	if err := src.Export(&buf); err != nil {
+		t.Fatalf("failed to export: %v", err)
+	}
+	dst := NewSignProtection(ethdb.NewMemDatabase())
+	dst.Record(1, 12, 0)
+	if err := dst.Import(&buf); err != nil {
+		t.Fatalf("failed to import: %v", err)
+	}
+	records, err := dst.Records()
+	if err != nil {
+		t.Fatalf("failed to read records: %v", err)
+	}
+	want := []*SignRecord{
+		{Round: 0, Height: 5, Period: 1},
+		{Round: 1, Height: 12, Period: 0},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("records mismatch: have %v, want %v", records, want)
+	}
+	// Records are persisted, a new store on the same database sees them.
+	if record, _ := NewSignProtection(db).Get(1); record == nil || record.Height != 10 {
+		t.Errorf("persisted record mismatch: have %v", record)
+	}
+}
+
+func TestSignProtectionLatestCache(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	NewSignProtection(db).Record(2, 20, 0)
+
+	// The latest record is loaded from the database once and then follows
+	// the records written through the store.
+	store := NewSignProtection(db)
+	want := &SignRecord{Round: 2, Height: 20, Period: 0}
+	if latest, _ := store.Latest(); !reflect.DeepEqual(latest, want) {
+		t.Fatalf("loaded latest mismatch: have %v, want %v", latest, want)
+	}
+	store.Record(1, 30, 0)
+	if latest, _ := store.Latest(); !reflect.DeepEqual(latest, want) {
+		t.Errorf("latest lowered by an older round: have %v, want %v", latest, want)
+	}
+	store.Record(3, 5, 1)
+	want = &SignRecord{Round: 3, Height: 5, Period: 1}
+	if latest, _ := store.Latest(); !reflect.DeepEqual(latest, want) {
+		t.Errorf("latest mismatch: have %v, want %v", latest, want)
+	}
+	// The returned record is a copy.
+	latest, _ := store.Latest()
+	latest.Height = 100
+	if latest, _ := store.Latest(); !reflect.DeepEqual(latest, want) {
+		t.Errorf("latest modified through a returned record: have %v", latest)
+	}
+}
+
+func TestSignProtectionUnsyncedDatabase(t *testing.T) {
+	store := NewSignProtection(ethdb.NewTable(ethdb.NewMemDatabase(), "prefix"))
+	if err := store.Record(1, 10, 0); err == nil {
+		t.Fatal("record written to a database which cannot sync writes")
+	}
+}
@@ -0,0 +1,581 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package simulations runs a network of full DEXON nodes in a single process,
+// connected over in-memory pipes, for integration testing of consensus, sync
+// and recovery.
+package simulations
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	dexCore "github.com/dexon-foundation/dexon-consensus/core"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/dex"
+	"github.com/dexon-foundation/dexon/dex/downloader"
+	"github.com/dexon-foundation/dexon/log"
+	"github.com/dexon-foundation/dexon/node"
+	"github.com/dexon-foundation/dexon/p2p"
+	"github.com/dexon-foundation/dexon/p2p/enode"
+	"github.com/dexon-foundation/dexon/p2p/simulations/pipes"
+)
+
+var (
+	errNodeDown      = errors.New("node is down")
+	errNodeUp        = errors.New("node is already up")
+	errUnreachable   = errors.New("node unreachable")
+	errUnknownIndex  = errors.New("unknown node index")
+	errNotConfigured = errors.New("round not configured yet")
+)
+
+// loopback is the address the simulated nodes pretend to listen on.
+var loopback = net.IPv4(127, 0, 0, 1)
+
+// pollInterval is the interval of checking the chain heads of the nodes
+// while waiting for a condition.
+const pollInterval = 100 * time.Millisecond
+
+// Config is the configuration of a simulated network.
+type Config struct {
+	// Notaries is the number of nodes registered in the genesis block.
+	Notaries int
+
+	// Spares is the number of funded nodes not registered in the genesis
+	// block, they can join the network as notaries with AddNotary.
+	Spares int
+
+	// BlockInterval is the minimum block interval of the network.
+	BlockInterval time.Duration
+
+	// RoundLength is the number of blocks in a round.
+	RoundLength uint64
+
+	// StartDelay is the time given to the notaries to start up before the
+	// consensus begins.
+	StartDelay time.Duration
+}
+
+// DefaultConfig contains the default settings of a simulated network.
+var DefaultConfig = Config{
+	Notaries:      4,
+	BlockInterval: 500 * time.Millisecond,
+	RoundLength:   100,
+	StartDelay:    5 * time.Second,
+}
+
+// Node is a simulated DEXON node.
+type Node struct {
+	Index int
+	Key   *ecdsa.PrivateKey
+
+	dataDir string
+	record  *enode.Node
+
+	lock  sync.RWMutex
+	stack *node.Node
+	dexon *dex.Dexon
+}
+
+// ID returns the p2p identity of the node.
+func (n *Node) ID() enode.ID {
+	return n.record.ID()
+}
+
+// Address returns the address of the node owner account.
+func (n *Node) Address() common.Address {
+	return crypto.PubkeyToAddress(n.Key.PublicKey)
+}
+
+// Dexon returns the running DEXON service of the node, or nil if the node is
+// down.
+func (n *Node) Dexon() *dex.Dexon {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return n.dexon
+}
+
+// Up reports whether the node is running.
+func (n *Node) Up() bool {
+	return n.Dexon() != nil
+}
+
+// server returns the p2p server of the node, or nil if the node is down.
+func (n *Node) server() *p2p.Server {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	if n.stack == nil {
+		return nil
+	}
+	return n.stack.Server()
+}
+
+// Network is a simulated network of DEXON nodes.
+type Network struct {
+	config  Config
+	genesis *core.Genesis
+	signer  types.Signer
+	dataDir string
+	nodes   []*Node
+
+	lock  sync.RWMutex
+	group map[int]int // node index to partition group
+}
+
+// NewNetwork creates a simulated network. The nodes are not started until
+// Start is called.
+func NewNetwork(config Config) (*Network, error) {
+	if config.Notaries < 1 {
+		return nil, errors.New("at least one notary is required")
+	}
+	dataDir, err := ioutil.TempDir("", "dex-simulation-")
+	if err != nil {
+		return nil, err
+	}
+	nw := &Network{
+		config:  config,
+		dataDir: dataDir,
+		group:   make(map[int]int),
+	}
+	for i := 0; i < config.Notaries+config.Spares; i++ {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			os.RemoveAll(dataDir)
+			return nil, err
+		}
+		nw.nodes = append(nw.nodes, &Node{
+			Index:   i,
+			Key:     key,
+			dataDir: filepath.Join(dataDir, fmt.Sprintf("node%d", i)),
+			record:  enode.NewV4(&key.PublicKey, loopback, 30303+i, 0),
+		})
+	}
+	return nw, nil
+}
+
+// Nodes returns all nodes of the network, the notaries registered in the
+// genesis block come first.
+func (nw *Network) Nodes() []*Node {
+	return nw.nodes
+}
+
+// Node returns the node of the given index.
+func (nw *Network) Node(i int) (*Node, error) {
+	if i < 0 || i >= len(nw.nodes) {
+		return nil, errUnknownIndex
+	}
+	return nw.nodes[i], nil
+}
+
+// Genesis returns the genesis of the network, it is available once the
+// network is started.
+func (nw *Network) Genesis() *core.Genesis {
+	return nw.genesis
+}
+
+// Start creates the genesis block and starts the notaries registered in it.
+func (nw *Network) Start() error {
+	if nw.genesis != nil {
+		return errors.New("network already started")
+	}
+	var keys []*ecdsa.PublicKey
+	for _, n := range nw.nodes[:nw.config.Notaries] {
+		keys = append(keys, &n.Key.PublicKey)
+	}
+	dMoment := uint64(time.Now().Add(nw.config.StartDelay).Unix())
+	period := uint64(nw.config.BlockInterval / time.Millisecond)
+	genesis := core.DexconDeveloperGenesisBlock(period, dMoment, keys[0], keys...)
+	genesis.Config.Dexcon.RoundLength = nw.config.RoundLength
+	for _, n := range nw.nodes[nw.config.Notaries:] {
+		genesis.Alloc[n.Address()] = core.GenesisAccount{
+			Balance: new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e7)),
+			Staked:  big.NewInt(0),
+		}
+	}
+	nw.genesis = genesis
+	nw.signer = types.NewEIP155Signer(genesis.Config.ChainID)
+
+	for i := 0; i < nw.config.Notaries; i++ {
+		if err := nw.startNode(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown stops all nodes and removes their data.
+func (nw *Network) Shutdown() {
+	for i := range nw.nodes {
+		nw.Crash(i)
+	}
+	os.RemoveAll(nw.dataDir)
+}
+
+// Crash stops the node of the given index, its data is kept for Restart.
+func (nw *Network) Crash(i int) error {
+	n, err := nw.Node(i)
+	if err != nil {
+		return err
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.stack == nil {
+		return errNodeDown
+	}
+	err = n.stack.Stop()
+	n.stack, n.dexon = nil, nil
+	return err
+}
+
+// Restart starts a stopped node, keeping the data of its previous run.
+func (nw *Network) Restart(i int) error {
+	return nw.startNode(i)
+}
+
+// startNode starts the node of the given index and connects it to the
+// reachable running nodes.
+func (nw *Network) startNode(i int) error {
+	n, err := nw.Node(i)
+	if err != nil {
+		return err
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.stack != nil {
+		return errNodeUp
+	}
+	stack, err := node.New(&node.Config{
+		Name:    "gdex",
+		DataDir: n.dataDir,
+		P2P: p2p.Config{
+			PrivateKey:  n.Key,
+			MaxPeers:    math.MaxInt32,
+			NoDiscovery: true,
+			Dialer:      &dialer{network: nw, from: i},
+		},
+		NoUSB:  true,
+		Logger: log.New("node", i),
+	})
+	if err != nil {
+		return err
+	}
+	config := dex.DefaultConfig
+	config.Genesis = nw.genesis
+	config.NetworkId = nw.genesis.Config.ChainID.Uint64()
+	config.PrivateKey = n.Key
+	config.SyncMode = downloader.FullSync
+	config.BlockProposerEnabled = true
+	config.DatabaseCache = 16
+	config.TrieCleanCache = 16
+	config.TrieDirtyCache = 16
+	config.TxPool.Journal = ""
+	if err := stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
+		return dex.New(ctx, &config)
+	}); err != nil {
+		return err
+	}
+	if err := stack.Start(); err != nil {
+		return err
+	}
+	var dexon *dex.Dexon
+	if err := stack.Service(&dexon); err != nil {
+		stack.Stop()
+		return err
+	}
+	n.stack, n.dexon = stack, dexon
+
+	for _, other := range nw.nodes {
+		if other != n && nw.reachable(i, other.Index) {
+			stack.Server().AddPeer(other.record)
+		}
+	}
+	return nil
+}
+
+// Partition splits the network into the given groups of node indexes, nodes
+// in different groups can not reach each other. Nodes not listed belong to
+// an extra group of their own.
+func (nw *Network) Partition(groups ...[]int) {
+	nw.lock.Lock()
+	nw.group = make(map[int]int)
+	for g, group := range groups {
+		for _, i := range group {
+			nw.group[i] = g + 1
+		}
+	}
+	nw.lock.Unlock()
+
+	for _, n := range nw.nodes {
+		srv := n.server()
+		if srv == nil {
+			continue
+		}
+		for _, other := range nw.nodes {
+			if other != n && !nw.reachable(n.Index, other.Index) {
+				srv.RemovePeer(other.record)
+			}
+		}
+		for _, p := range srv.Peers() {
+			if other := nw.nodeByID(p.ID()); other != nil && !nw.reachable(n.Index, other.Index) {
+				p.Disconnect(p2p.DiscRequested)
+			}
+		}
+	}
+}
+
+// Heal removes all partitions and reconnects the running nodes.
+func (nw *Network) Heal() {
+	nw.lock.Lock()
+	nw.group = make(map[int]int)
+	nw.lock.Unlock()
+
+	for _, n := range nw.nodes {
+		srv := n.server()
+		if srv == nil {
+			continue
+		}
+		for _, other := range nw.nodes {
+			if other != n {
+				srv.AddPeer(other.record)
+			}
+		}
+	}
+}
+
+func (nw *Network) reachable(a, b int) bool {
+	nw.lock.RLock()
+	defer nw.lock.RUnlock()
+	return nw.group[a] == nw.group[b]
+}
+
+func (nw *Network) nodeByID(id enode.ID) *Node {
+	for _, n := range nw.nodes {
+		if n.ID() == id {
+			return n
+		}
+	}
+	return nil
+}
+
+// AddNotary stakes the funds of the node of the given index in the
+// governance contract to register it as a notary candidate. It is selected
+// into the notary set from the rounds configured after the registration, the
+// node itself may be started later with Restart.
+func (nw *Network) AddNotary(i int) error {
+	n, err := nw.Node(i)
+	if err != nil {
+		return err
+	}
+	input, err := vm.GovernanceABI.ABI.Pack("register", crypto.FromECDSAPub(&n.Key.PublicKey),
+		fmt.Sprintf("Node %d", i), "", "", "")
+	if err != nil {
+		return err
+	}
+	return nw.sendGovTx(n, nw.genesis.Config.Dexcon.MinStake, input)
+}
+
+// RemoveNotary unstakes all funds of the node of the given index, so that it
+// is no longer selected into the notary set.
+func (nw *Network) RemoveNotary(i int) error {
+	n, err := nw.Node(i)
+	if err != nil {
+		return err
+	}
+	input, err := vm.GovernanceABI.ABI.Pack("unstake", nw.genesis.Config.Dexcon.MinStake)
+	if err != nil {
+		return err
+	}
+	return nw.sendGovTx(n, big.NewInt(0), input)
+}
+
+// NodeSet returns the sorted indices of the nodes qualified as notary
+// candidates for the given round, as configured in the governance contract.
+func (nw *Network) NodeSet(round uint64) ([]int, error) {
+	dexon := nw.anyDexon()
+	if dexon == nil {
+		return nil, errNodeDown
+	}
+	gov := core.NewGovernance(core.NewGovernanceStateDB(dexon.BlockChain()))
+	if round >= dexCore.ConfigRoundShift {
+		if configRound := round - dexCore.ConfigRoundShift; configRound != 0 &&
+			gov.GetRoundHeight(configRound) == 0 {
+			return nil, errNotConfigured
+		}
+	}
+	var set []int
+	for _, key := range gov.GetSnapshotForConfigAtRound(round).NodePublicKeys {
+		for _, n := range nw.nodes {
+			if bytes.Equal(key, crypto.FromECDSAPub(&n.Key.PublicKey)) {
+				set = append(set, n.Index)
+			}
+		}
+	}
+	sort.Ints(set)
+	return set, nil
+}
+
+// anyDexon returns the DEXON service of the first running node, or nil if all
+// nodes are down.
+func (nw *Network) anyDexon() *dex.Dexon {
+	for _, n := range nw.nodes {
+		if dexon := n.Dexon(); dexon != nil {
+			return dexon
+		}
+	}
+	return nil
+}
+
+// sendGovTx sends a transaction from the node owner to the governance
+// contract through the transaction pool of the node, or of any running node
+// if it is down.
+func (nw *Network) sendGovTx(n *Node, value *big.Int, input []byte) error {
+	dexon := n.Dexon()
+	if dexon == nil {
+		dexon = nw.anyDexon()
+	}
+	if dexon == nil {
+		return errNodeDown
+	}
+	pool := dexon.TxPool()
+	nonce := pool.State().GetNonce(n.Address())
+	price := nw.genesis.Config.Dexcon.MinGasPrice
+	tx := types.NewTransaction(nonce, vm.GovernanceContractAddress, value, 1000000, price, input)
+	tx, err := types.SignTx(tx, nw.signer, n.Key)
+	if err != nil {
+		return err
+	}
+	return pool.AddLocal(tx)
+}
+
+// WaitHeight waits until all running nodes reach the given block height.
+func (nw *Network) WaitHeight(height uint64, timeout time.Duration) error {
+	return nw.wait(timeout, func(b *types.Block) bool {
+		return b.NumberU64() >= height
+	}, fmt.Sprintf("height %d", height))
+}
+
+// WaitRound waits until all running nodes reach the given round.
+func (nw *Network) WaitRound(round uint64, timeout time.Duration) error {
+	return nw.wait(timeout, func(b *types.Block) bool {
+		return b.Round() >= round
+	}, fmt.Sprintf("round %d", round))
+}
+
+func (nw *Network) wait(timeout time.Duration, reached func(*types.Block) bool, what string) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done := true
+		for _, n := range nw.nodes {
+			if dexon := n.Dexon(); dexon != nil && !reached(dexon.BlockChain().CurrentBlock()) {
+				done = false
+				break
+			}
+		}
+		if done {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for %s, heads: %v", what, nw.Heights())
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// Heights returns the chain head height of every running node.
+func (nw *Network) Heights() map[int]uint64 {
+	heights := make(map[int]uint64)
+	for _, n := range nw.nodes {
+		if dexon := n.Dexon(); dexon != nil {
+			heights[n.Index] = dexon.BlockChain().CurrentBlock().NumberU64()
+		}
+	}
+	return heights
+}
+
+// CheckFinality checks that all running nodes agree on every block up to the
+// lowest chain head among them.
+func (nw *Network) CheckFinality() error {
+	var chains []*core.BlockChain
+	var indexes []int
+	lowest := uint64(math.MaxUint64)
+	for _, n := range nw.nodes {
+		dexon := n.Dexon()
+		if dexon == nil {
+			continue
+		}
+		chain := dexon.BlockChain()
+		if head := chain.CurrentBlock().NumberU64(); head < lowest {
+			lowest = head
+		}
+		chains = append(chains, chain)
+		indexes = append(indexes, n.Index)
+	}
+	if len(chains) < 2 {
+		return nil
+	}
+	for height := uint64(1); height <= lowest; height++ {
+		want := chains[0].GetHeaderByNumber(height).Hash()
+		for j, chain := range chains[1:] {
+			if have := chain.GetHeaderByNumber(height).Hash(); have != want {
+				return fmt.Errorf("block %d mismatch: node %d has %x, node %d has %x",
+					height, indexes[0], want, indexes[j+1], have)
+			}
+		}
+	}
+	return nil
+}
+
+// dialer connects the p2p server of a node to the other nodes over in-memory
+// pipes, refusing to connect nodes in different partitions.
+type dialer struct {
+	network *Network
+	from    int
+}
+
+func (d *dialer) Dial(dest *enode.Node) (net.Conn, error) {
+	n := d.network.nodeByID(dest.ID())
+	if n == nil || !d.network.reachable(d.from, n.Index) {
+		return nil, errUnreachable
+	}
+	srv := n.server()
+	if srv == nil {
+		return nil, errNodeDown
+	}
+	local, remote, err := pipes.NetPipe()
+	if err != nil {
+		return nil, err
+	}
+	go srv.SetupConn(local, 0, nil)
+	return remote, nil
+}
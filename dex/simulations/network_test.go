@@ -0,0 +1,136 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package simulations
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestNetwork(t *testing.T, config Config) *Network {
+	if testing.Short() {
+		t.Skip("skipping simulated network in short mode")
+	}
+	nw, err := NewNetwork(config)
+	if err != nil {
+		t.Fatalf("failed to create network: %v", err)
+	}
+	if err := nw.Start(); err != nil {
+		nw.Shutdown()
+		t.Fatalf("failed to start network: %v", err)
+	}
+	return nw
+}
+
+func TestCrashRestart(t *testing.T) {
+	nw := newTestNetwork(t, DefaultConfig)
+	defer nw.Shutdown()
+
+	if err := nw.WaitHeight(5, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	// The remaining notaries keep finalizing blocks.
+	if err := nw.Crash(3); err != nil {
+		t.Fatalf("failed to crash node: %v", err)
+	}
+	if err := nw.WaitHeight(10, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	// The restarted notary catches up with the others.
+	if err := nw.Restart(3); err != nil {
+		t.Fatalf("failed to restart node: %v", err)
+	}
+	if err := nw.WaitHeight(20, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := nw.CheckFinality(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	nw := newTestNetwork(t, DefaultConfig)
+	defer nw.Shutdown()
+
+	if err := nw.WaitHeight(5, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	// Neither half has enough notaries to finalize blocks.
+	nw.Partition([]int{0, 1}, []int{2, 3})
+	time.Sleep(5 * time.Second)
+	heights := nw.Heights()
+	time.Sleep(5 * time.Second)
+	for i, height := range nw.Heights() {
+		if height > heights[i]+1 {
+			t.Errorf("node %d finalized blocks while partitioned: %d -> %d", i, heights[i], height)
+		}
+	}
+	if err := nw.CheckFinality(); err != nil {
+		t.Fatal(err)
+	}
+
+	nw.Heal()
+	var highest uint64
+	for _, height := range nw.Heights() {
+		if height > highest {
+			highest = height
+		}
+	}
+	if err := nw.WaitHeight(highest+5, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := nw.CheckFinality(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAddRemoveNotary(t *testing.T) {
+	config := DefaultConfig
+	config.Spares = 1
+	nw := newTestNetwork(t, config)
+	defer nw.Shutdown()
+
+	if err := nw.AddNotary(4); err != nil {
+		t.Fatalf("failed to add notary: %v", err)
+	}
+	if err := nw.RemoveNotary(3); err != nil {
+		t.Fatalf("failed to remove notary: %v", err)
+	}
+	// The node set of a round is configured two rounds ahead.
+	if err := nw.WaitRound(1, 5*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := nw.CheckFinality(); err != nil {
+		t.Fatal(err)
+	}
+	for round, want := range [][]int{{0, 1, 2, 3}, {0, 1, 2, 3}, {0, 1, 2, 3}, {0, 1, 2, 4}} {
+		set, err := nw.NodeSet(uint64(round))
+		if err != nil {
+			t.Fatalf("failed to get node set of round %d: %v", round, err)
+		}
+		if !reflect.DeepEqual(set, want) {
+			t.Errorf("node set of round %d mismatch: have %v, want %v", round, set, want)
+		}
+	}
+	if _, err := nw.NodeSet(4); err != errNotConfigured {
+		t.Errorf("node set of round 4 error mismatch: have %v, want %v", err, errNotConfigured)
+	}
+}
@@ -125,6 +125,10 @@ func (b *EthAPIBackend) GetTd(blockHash common.Hash) *big.Int {
 	return b.eth.blockchain.GetTdByHash(blockHash)
 }
 
+func (b *EthAPIBackend) GetRoundRange(round uint64) (uint64, uint64, error) {
+	return 0, 0, ethapi.ErrRoundsNotSupported
+}
+
 func (b *EthAPIBackend) GetEVM(ctx context.Context, msg core.Message, state *state.StateDB, header *types.Header) (*vm.EVM, func() error, error) {
 	state.SetBalance(msg.From(), math.MaxBig256)
 	vmError := func() error { return nil }
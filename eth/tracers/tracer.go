@@ -300,6 +300,10 @@ type Tracer struct {
 	ctx map[string]interface{} // Transaction context gathered throughout execution
 	err error                  // Error, if one has occurred
 
+	hasOracle bool // Flag whether the tracer exposes an 'oracle' function
+
+	precompiles map[common.Address]vm.PrecompiledContract // Pre-compiles active in the traced block
+
 	interrupt uint32 // Atomic flag to signal execution interruption
 	reason    error  // Textual reason for the interruption
 }
@@ -390,7 +394,7 @@ func New(code string) (*Tracer, error) {
 		return 1
 	})
 	tracer.vm.PushGlobalGoFunction("isPrecompiled", func(ctx *duktape.Context) int {
-		_, ok := vm.PrecompiledContractsByzantium[common.BytesToAddress(popSlice(ctx))]
+		_, ok := tracer.precompiles[common.BytesToAddress(popSlice(ctx))]
 		ctx.PushBoolean(ok)
 		return 1
 	})
@@ -433,6 +437,11 @@ func New(code string) (*Tracer, error) {
 	}
 	tracer.vm.Pop()
 
+	// The oracle function is optional, tracers not caring about oracle
+	// contracts may leave it out.
+	tracer.hasOracle = tracer.vm.GetPropString(tracer.tracerObject, "oracle")
+	tracer.vm.Pop()
+
 	// Tracer is valid, inject the big int library to access large numbers
 	tracer.vm.EvalString(bigIntegerJS)
 	tracer.vm.PutGlobalString("bigInt")
@@ -537,6 +546,7 @@ func (jst *Tracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost
 		// Initialize the context if it wasn't done yet
 		if !jst.inited {
 			jst.ctx["block"] = env.BlockNumber.Uint64()
+			jst.precompiles = vm.ActivePrecompiledContracts(env.ChainConfig(), env.BlockNumber)
 			jst.inited = true
 		}
 		// If tracing was interrupted, set the error and stop
@@ -585,6 +595,28 @@ func (jst *Tracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost
 	return nil
 }
 
+// CaptureOracle implements the OracleTracer interface to pass the execution
+// frame of an oracle contract to the optional 'oracle' tracer function.
+func (jst *Tracer) CaptureOracle(env *vm.EVM, frame *vm.OracleFrame) error {
+	if jst.err == nil && jst.hasOracle {
+		blob, err := json.Marshal(frame)
+		if err != nil {
+			jst.err = wrapError("oracle", err)
+			return nil
+		}
+		jst.dbWrapper.db = env.StateDB
+
+		jst.vm.PushString(string(blob))
+		jst.vm.JsonDecode(-1)
+		jst.vm.PutPropString(jst.stateObject, "frame")
+
+		if _, err := jst.call("oracle", "frame", "db"); err != nil {
+			jst.err = wrapError("oracle", err)
+		}
+	}
+	return nil
+}
+
 // CaptureEnd is called after the call finishes to finalize the tracing.
 func (jst *Tracer) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
 	jst.ctx["output"] = output
@@ -108,6 +108,58 @@ func TestOpcodes(t *testing.T) {
 	}
 }
 
+func TestOracle(t *testing.T) {
+	tracer, err := New("{frames: [], step: function() {}, fault: function() {}, oracle: function(frame) { this.frames.push(frame.method + ':' + frame.args.Name + ':' + frame.gasUsed); }, result: function() { return this.frames; }}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := vm.NewEVM(vm.Context{BlockNumber: big.NewInt(1)}, &dummyStatedb{}, params.TestChainConfig, vm.Config{Debug: true, Tracer: tracer})
+	frame := &vm.OracleFrame{
+		Method:  "register",
+		Args:    map[string]interface{}{"Name": "Test1"},
+		GasUsed: 100,
+	}
+	if err := tracer.CaptureOracle(env, frame); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := tracer.GetResult()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(ret, []byte("[\"register:Test1:100\"]")) {
+		t.Errorf("Expected return value to be [\"register:Test1:100\"], got %s", string(ret))
+	}
+}
+
+func TestIsPrecompiled(t *testing.T) {
+	config := *params.TestChainConfig
+	config.BLSVerifyBlock = big.NewInt(100)
+
+	for _, tt := range []struct {
+		number int64
+		want   string
+	}{
+		{99, "[false,true]"},
+		{100, "[true,true]"},
+	} {
+		tracer, err := New("{res: null, step: function() { this.res = [isPrecompiled(toAddress('0x09')), isPrecompiled(toAddress('0x01'))]; }, fault: function() {}, result: function() { return this.res; }}")
+		if err != nil {
+			t.Fatal(err)
+		}
+		env := vm.NewEVM(vm.Context{BlockNumber: big.NewInt(tt.number)}, &dummyStatedb{}, &config, vm.Config{Debug: true, Tracer: tracer})
+		contract := vm.NewContract(&account{}, &account{}, big.NewInt(0), 0)
+
+		tracer.CaptureState(env, 0, 0, 0, 0, nil, nil, contract, 0, nil)
+		ret, err := tracer.GetResult()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(ret) != tt.want {
+			t.Errorf("block %d: expected %s, got %s", tt.number, tt.want, ret)
+		}
+	}
+}
+
 func TestHalt(t *testing.T) {
 	t.Skip("duktape doesn't support abortion")
 
@@ -0,0 +1,353 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// +build !js
+
+package ethdb
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dexon-foundation/dexon/log"
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/badger/skl"
+)
+
+// EngineBadger is the name of the Badger key-value store engine.
+const EngineBadger = "badger"
+
+// badgerGCInterval is the interval of the value log garbage collection.
+const badgerGCInterval = 5 * time.Minute
+
+// badgerMinTableSize is the smallest table size used, which keeps the
+// transaction limit of Badger well above the batches flushed at IdealBatchSize.
+const badgerMinTableSize = 4 * 1024 * 1024
+
+// badgerKeyPrefix prefixes all the keys stored in Badger, which rejects empty
+// keys and reserves the keys starting with "!badger!" for internal use.
+var badgerKeyPrefix = []byte{'k'}
+
+func init() {
+	RegisterEngine(EngineBadger, func(file string, cache int, handles int) (Database, error) {
+		return NewBadgerDatabase(file, cache, handles)
+	})
+}
+
+// BadgerDatabase is a persistent database backed by the Badger key-value store.
+type BadgerDatabase struct {
+	fn string     // filename for reporting
+	db *badger.DB // Badger instance
+
+	quitLock sync.Mutex    // Mutex protecting the quit channel access
+	quitChan chan struct{} // Quit channel to stop the value log garbage collection
+	wg       sync.WaitGroup
+
+	log log.Logger // Contextual logger tracking the database path
+}
+
+// NewBadgerDatabase returns a Badger wrapped object. Badger memory maps its
+// files, so handles is not used and cache is spent on the memtables.
+func NewBadgerDatabase(file string, cache int, handles int) (*BadgerDatabase, error) {
+	logger := log.New("database", file)
+
+	// Ensure we have some minimal caching guarantees
+	if cache < 16 {
+		cache = 16
+	}
+	logger.Info("Allocated cache", "cache", cache)
+
+	// Unlike LevelDB, Badger doesn't create the parent directories
+	if err := os.MkdirAll(file, 0700); err != nil {
+		return nil, err
+	}
+	opts := badger.DefaultOptions(file).
+		WithLogger(badgerLogger{logger}).
+		WithSyncWrites(false). // Same durability as LevelDB
+		WithTruncate(true)     // Drop the value log tail torn by a crash
+	if size := int64(cache/4) * 1024 * 1024 / int64(opts.NumMemtables); size < opts.MaxTableSize {
+		if size < badgerMinTableSize {
+			size = badgerMinTableSize
+		}
+		opts = opts.WithMaxTableSize(size)
+	}
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	bdb := &BadgerDatabase{
+		fn:       file,
+		db:       db,
+		quitChan: make(chan struct{}),
+		log:      logger,
+	}
+	bdb.wg.Add(1)
+	go bdb.collectGarbage()
+	return bdb, nil
+}
+
+func badgerKey(key []byte) []byte {
+	return append(append(make([]byte, 0, len(badgerKeyPrefix)+len(key)), badgerKeyPrefix...), key...)
+}
+
+// Path returns the path to the database directory.
+func (db *BadgerDatabase) Path() string {
+	return db.fn
+}
+
+// Put puts the given key / value to the queue
+func (db *BadgerDatabase) Put(key []byte, value []byte) error {
+	return db.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(badgerKey(key), value)
+	})
+}
+
+// PutSync puts the given key / value and syncs the value log to disk.
+func (db *BadgerDatabase) PutSync(key []byte, value []byte) error {
+	if err := db.Put(key, value); err != nil {
+		return err
+	}
+	return db.db.Sync()
+}
+
+func (db *BadgerDatabase) Has(key []byte) (bool, error) {
+	err := db.db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(badgerKey(key))
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Get returns the given key if it's present.
+func (db *BadgerDatabase) Get(key []byte) ([]byte, error) {
+	var dat []byte
+	err := db.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(badgerKey(key))
+		if err != nil {
+			return err
+		}
+		dat, err = item.ValueCopy(nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dat, nil
+}
+
+// Delete deletes the key from the queue and database
+func (db *BadgerDatabase) Delete(key []byte) error {
+	return db.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(badgerKey(key))
+	})
+}
+
+// NewIteratorWithPrefix returns a iterator to iterate over subset of database content with a particular prefix.
+func (db *BadgerDatabase) NewIteratorWithPrefix(prefix []byte) Iterator {
+	txn := db.db.NewTransaction(false)
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = badgerKey(prefix)
+	return &badgerIterator{
+		txn:    txn,
+		it:     txn.NewIterator(opts),
+		prefix: opts.Prefix,
+	}
+}
+
+func (db *BadgerDatabase) Close() {
+	// Stop the garbage collection to avoid racing with the close
+	db.quitLock.Lock()
+	defer db.quitLock.Unlock()
+
+	if db.quitChan != nil {
+		close(db.quitChan)
+		db.wg.Wait()
+		db.quitChan = nil
+	}
+	err := db.db.Close()
+	if err == nil {
+		db.log.Info("Database closed")
+	} else {
+		db.log.Error("Failed to close database", "err", err)
+	}
+}
+
+// collectGarbage periodically rewrites the value log files holding mostly
+// stale entries, which Badger never does on its own.
+func (db *BadgerDatabase) collectGarbage() {
+	defer db.wg.Done()
+
+	ticker := time.NewTicker(badgerGCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for {
+				err := db.db.RunValueLogGC(0.5)
+				if err == badger.ErrNoRewrite {
+					break
+				}
+				if err != nil {
+					db.log.Warn("Value log garbage collection failed", "err", err)
+					break
+				}
+				db.log.Debug("Rewrote value log file")
+			}
+		case <-db.quitChan:
+			return
+		}
+	}
+}
+
+func (db *BadgerDatabase) NewBatch() Batch {
+	return &badgerBatch{db: db.db}
+}
+
+// badgerBatch is a write batch of a BadgerDatabase. It is written in a single
+// transaction, so a batch exceeding the transaction size limit of Badger fails
+// as a whole.
+type badgerBatch struct {
+	db   *badger.DB
+	ops  []badgerOp
+	size int
+}
+
+type badgerOp struct {
+	key, value []byte
+	delete     bool
+}
+
+// Put queues the key / value. Badger limits the number of entries of a
+// transaction as well as their size, so each entry is accounted with the
+// memtable node it takes up to let callers flush the batch in time.
+func (b *badgerBatch) Put(key, value []byte) error {
+	b.ops = append(b.ops, badgerOp{key: badgerKey(key), value: append([]byte(nil), value...)})
+	b.size += len(key) + len(value) + skl.MaxNodeSize
+	return nil
+}
+
+func (b *badgerBatch) Delete(key []byte) error {
+	b.ops = append(b.ops, badgerOp{key: badgerKey(key), delete: true})
+	b.size += len(key) + skl.MaxNodeSize
+	return nil
+}
+
+func (b *badgerBatch) Write() error {
+	txn := b.db.NewTransaction(true)
+	defer txn.Discard()
+
+	for _, op := range b.ops {
+		var err error
+		if op.delete {
+			err = txn.Delete(op.key)
+		} else {
+			err = txn.Set(op.key, op.value)
+		}
+		if err == badger.ErrTxnTooBig {
+			return fmt.Errorf("batch of %d operations and %d bytes exceeds the badger transaction limit", len(b.ops), b.size)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return txn.Commit()
+}
+
+func (b *badgerBatch) ValueSize() int {
+	return b.size
+}
+
+func (b *badgerBatch) Reset() {
+	b.ops = b.ops[:0]
+	b.size = 0
+}
+
+// badgerIterator iterates over the entries of a read-only transaction.
+type badgerIterator struct {
+	txn     *badger.Txn
+	it      *badger.Iterator
+	prefix  []byte
+	started bool
+	value   []byte
+	err     error
+}
+
+func (it *badgerIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if !it.started {
+		it.it.Seek(it.prefix)
+		it.started = true
+	} else {
+		it.it.Next()
+	}
+	if !it.it.ValidForPrefix(it.prefix) {
+		it.value = nil
+		return false
+	}
+	it.value, it.err = it.it.Item().ValueCopy(nil)
+	return it.err == nil
+}
+
+func (it *badgerIterator) Error() error {
+	return it.err
+}
+
+func (it *badgerIterator) Key() []byte {
+	if !it.started || !it.it.ValidForPrefix(it.prefix) {
+		return nil
+	}
+	return it.it.Item().Key()[len(badgerKeyPrefix):]
+}
+
+func (it *badgerIterator) Value() []byte {
+	return it.value
+}
+
+func (it *badgerIterator) Release() {
+	it.it.Close()
+	it.txn.Discard()
+}
+
+// badgerLogger forwards the logs of Badger to a contextual logger. Routine
+// compaction reports are logged at debug level.
+type badgerLogger struct {
+	log log.Logger
+}
+
+func (l badgerLogger) Errorf(format string, args ...interface{}) {
+	l.log.Error(strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+func (l badgerLogger) Warningf(format string, args ...interface{}) {
+	l.log.Warn(strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+func (l badgerLogger) Infof(format string, args ...interface{}) {
+	l.log.Debug(strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+func (l badgerLogger) Debugf(format string, args ...interface{}) {
+	l.log.Trace(strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
@@ -56,6 +56,11 @@ func (db *LDBDatabase) Delete(key []byte) error {
 	return errNotSupported
 }
 
+// NewIteratorWithPrefix returns a iterator to iterate over subset of database content with a particular prefix.
+func (db *LDBDatabase) NewIteratorWithPrefix(prefix []byte) Iterator {
+	return emptyIterator{}
+}
+
 func (db *LDBDatabase) Close() {
 }
 
@@ -66,3 +71,12 @@ func (db *LDBDatabase) Meter(prefix string) {
 func (db *LDBDatabase) NewBatch() Batch {
 	return nil
 }
+
+// emptyIterator is an exhausted iterator reporting the database as unsupported.
+type emptyIterator struct{}
+
+func (emptyIterator) Next() bool    { return false }
+func (emptyIterator) Error() error  { return errNotSupported }
+func (emptyIterator) Key() []byte   { return nil }
+func (emptyIterator) Value() []byte { return nil }
+func (emptyIterator) Release()      {}
@@ -46,6 +46,22 @@ func newTestLDB() (*ethdb.LDBDatabase, func()) {
 	}
 }
 
+func newTestBadger() (*ethdb.BadgerDatabase, func()) {
+	dirname, err := ioutil.TempDir(os.TempDir(), "ethdb_test_")
+	if err != nil {
+		panic("failed to create test file: " + err.Error())
+	}
+	db, err := ethdb.NewBadgerDatabase(dirname, 0, 0)
+	if err != nil {
+		panic("failed to create test database: " + err.Error())
+	}
+
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dirname)
+	}
+}
+
 var test_values = []string{"", "a", "1251", "\x00123\x00"}
 
 func TestLDB_PutGet(t *testing.T) {
@@ -54,6 +70,12 @@ func TestLDB_PutGet(t *testing.T) {
 	testPutGet(db, t)
 }
 
+func TestBadger_PutGet(t *testing.T) {
+	db, remove := newTestBadger()
+	defer remove()
+	testPutGet(db, t)
+}
+
 func TestMemoryDB_PutGet(t *testing.T) {
 	testPutGet(ethdb.NewMemDatabase(), t)
 }
@@ -153,6 +175,12 @@ func TestLDB_ParallelPutGet(t *testing.T) {
 	testParallelPutGet(db, t)
 }
 
+func TestBadger_ParallelPutGet(t *testing.T) {
+	db, remove := newTestBadger()
+	defer remove()
+	testParallelPutGet(db, t)
+}
+
 func TestMemoryDB_ParallelPutGet(t *testing.T) {
 	testParallelPutGet(ethdb.NewMemDatabase(), t)
 }
@@ -212,3 +240,82 @@ func testParallelPutGet(db ethdb.Database, t *testing.T) {
 	}
 	pending.Wait()
 }
+
+func TestLDB_IteratorWithPrefix(t *testing.T) {
+	db, remove := newTestLDB()
+	defer remove()
+	testIteratorWithPrefix(db, t)
+}
+
+func TestBadger_IteratorWithPrefix(t *testing.T) {
+	db, remove := newTestBadger()
+	defer remove()
+	testIteratorWithPrefix(db, t)
+}
+
+func TestMemoryDB_IteratorWithPrefix(t *testing.T) {
+	testIteratorWithPrefix(ethdb.NewMemDatabase(), t)
+}
+
+func TestTable_IteratorWithPrefix(t *testing.T) {
+	testIteratorWithPrefix(ethdb.NewTable(ethdb.NewMemDatabase(), "t-"), t)
+}
+
+func testIteratorWithPrefix(db ethdb.Database, t *testing.T) {
+	for _, k := range []string{"b2", "a1", "b1", "c1", "b3"} {
+		if err := db.Put([]byte(k), []byte("v"+k)); err != nil {
+			t.Fatalf("put failed: %v", err)
+		}
+	}
+
+	it := db.NewIteratorWithPrefix([]byte("b"))
+	defer it.Release()
+
+	var keys []string
+	for it.Next() {
+		if !bytes.Equal(it.Value(), append([]byte("v"), it.Key()...)) {
+			t.Fatalf("wrong value for key %q: got %q", it.Key(), it.Value())
+		}
+		keys = append(keys, string(it.Key()))
+	}
+	if err := it.Error(); err != nil {
+		t.Fatalf("iteration failed: %v", err)
+	}
+	if fmt.Sprint(keys) != "[b1 b2 b3]" {
+		t.Fatalf("iterated keys mismatch: got %v", keys)
+	}
+}
+
+func TestBadger_LargeBatch(t *testing.T) {
+	db, remove := newTestBadger()
+	defer remove()
+
+	// The batch exceeds the transaction limit of the small test database and
+	// must be rejected without writing any of its entries.
+	batch := db.NewBatch()
+	value := bytes.Repeat([]byte{0x42}, 1024)
+	for i := 0; i < 65536; i++ {
+		batch.Put([]byte(fmt.Sprintf("key-%d", i)), value)
+	}
+	if err := batch.Write(); err == nil {
+		t.Fatal("oversized batch written")
+	}
+	for i := 0; i < 65536; i++ {
+		if has, err := db.Has([]byte(fmt.Sprintf("key-%d", i))); err != nil || has {
+			t.Fatalf("key-%d written by failed batch: %v", i, err)
+		}
+	}
+	// Batches flushed at the ideal size are written.
+	batch.Reset()
+	for i := 0; batch.ValueSize() < ethdb.IdealBatchSize; i++ {
+		batch.Put([]byte(fmt.Sprintf("key-%d", i)), nil)
+	}
+	if err := batch.Write(); err != nil {
+		t.Fatalf("failed to write batch: %v", err)
+	}
+	for i := 0; i < 16; i++ {
+		if v, err := db.Get([]byte(fmt.Sprintf("key-%d", i))); err != nil || len(v) != 0 {
+			t.Fatalf("key-%d mismatch: %x, %v", i, v, err)
+		}
+	}
+}
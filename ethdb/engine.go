@@ -0,0 +1,161 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/log"
+)
+
+// EngineLevelDB is the name of the LevelDB key-value store engine, which is
+// the default database engine.
+const EngineLevelDB = "leveldb"
+
+// engineFile is the file in the database directory recording the engine the
+// database is created with.
+const engineFile = "ENGINE"
+
+// EngineOpener opens or creates a persistent database of a key-value store
+// engine in the given directory.
+type EngineOpener func(file string, cache int, handles int) (Database, error)
+
+var (
+	enginesLock sync.RWMutex
+	engines     = map[string]EngineOpener{
+		EngineLevelDB: func(file string, cache int, handles int) (Database, error) {
+			return NewLDBDatabase(file, cache, handles)
+		},
+	}
+)
+
+// RegisterEngine makes a key-value store engine available by the given name.
+// It panics if an engine with the same name is already registered.
+func RegisterEngine(name string, opener EngineOpener) {
+	enginesLock.Lock()
+	defer enginesLock.Unlock()
+
+	if _, ok := engines[name]; ok {
+		panic(fmt.Sprintf("database engine %q registered twice", name))
+	}
+	engines[name] = opener
+}
+
+// Engines returns the sorted names of the registered database engines.
+func Engines() []string {
+	enginesLock.RLock()
+	defer enginesLock.RUnlock()
+
+	names := make([]string, 0, len(engines))
+	for name := range engines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Open opens or creates a persistent database in the given directory with the
+// given engine, LevelDB if engine is empty. An existing database can only be
+// opened with the engine it is created with.
+func Open(engine string, file string, cache int, handles int) (Database, error) {
+	if engine == "" {
+		engine = EngineLevelDB
+	}
+	enginesLock.RLock()
+	opener, ok := engines[engine]
+	enginesLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown database engine %q, available: %s",
+			engine, strings.Join(Engines(), ", "))
+	}
+
+	marker := filepath.Join(file, engineFile)
+	if data, err := ioutil.ReadFile(marker); err == nil {
+		if existing := strings.TrimSpace(string(data)); existing != engine {
+			return nil, fmt.Errorf("database %s is created with engine %q, not %q",
+				file, existing, engine)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	} else if engine != EngineLevelDB && isDir(file) {
+		// Databases without an engine marker are created by LevelDB before
+		// the engine is selectable.
+		return nil, fmt.Errorf("database %s is created with engine %q, not %q",
+			file, EngineLevelDB, engine)
+	}
+
+	db, err := opener(file, cache, handles)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(marker, []byte(engine+"\n"), 0644); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// Copy copies every entry of src into dst, returning the number and the size
+// of the entries copied.
+func Copy(dst Database, src Database) (int, common.StorageSize, error) {
+	var (
+		start  = time.Now()
+		logged = time.Now()
+		count  int
+		size   common.StorageSize
+	)
+	it := src.NewIteratorWithPrefix(nil)
+	defer it.Release()
+
+	batch := dst.NewBatch()
+	for it.Next() {
+		if err := batch.Put(it.Key(), it.Value()); err != nil {
+			return count, size, err
+		}
+		count++
+		size += common.StorageSize(len(it.Key()) + len(it.Value()))
+
+		if batch.ValueSize() >= IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return count, size, err
+			}
+			batch.Reset()
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Copying database", "entries", count, "size", size,
+				"elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	if err := it.Error(); err != nil {
+		return count, size, err
+	}
+	return count, size, batch.Write()
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
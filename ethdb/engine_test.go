@@ -0,0 +1,111 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// +build !js
+
+package ethdb_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/ethdb"
+)
+
+func TestOpenEngine(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ethdb_engine_test_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ethdb.RegisterEngine("test-memory", func(string, int, int) (ethdb.Database, error) {
+		return ethdb.NewMemDatabase(), nil
+	})
+
+	file := filepath.Join(dir, "chaindata")
+	db, err := ethdb.Open("", file, 0, 0)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	db.Close()
+
+	if _, err := ethdb.Open("test-memory", file, 0, 0); err == nil {
+		t.Fatalf("opened leveldb database with another engine")
+	}
+	if _, err := ethdb.Open("unknown", filepath.Join(dir, "unknown"), 0, 0); err == nil {
+		t.Fatalf("opened database with unknown engine")
+	}
+	db, err = ethdb.Open(ethdb.EngineLevelDB, file, 0, 0)
+	if err != nil {
+		t.Fatalf("failed to reopen database: %v", err)
+	}
+	db.Close()
+}
+
+func TestCopyRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ethdb_engine_test_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := ethdb.NewMemDatabase()
+	for i := 0; i < 5000; i++ {
+		src.Put([]byte(fmt.Sprintf("key-%05d", i)), bytes.Repeat([]byte{byte(i)}, i%100))
+	}
+	src.Put([]byte{}, []byte("empty key"))
+
+	// Migrate from LevelDB to Badger and back.
+	var (
+		prev  ethdb.Database = src
+		files                = []string{"leveldb", "badger", "leveldb-back"}
+	)
+	for i, engine := range []string{ethdb.EngineLevelDB, ethdb.EngineBadger, ethdb.EngineLevelDB} {
+		db, err := ethdb.Open(engine, filepath.Join(dir, files[i]), 0, 0)
+		if err != nil {
+			t.Fatalf("failed to open %s database: %v", engine, err)
+		}
+		defer db.Close()
+
+		count, _, err := ethdb.Copy(db, prev)
+		if err != nil {
+			t.Fatalf("failed to copy into %s database: %v", engine, err)
+		}
+		if count != src.Len() {
+			t.Fatalf("%s: copied entries mismatch: have %d, want %d", engine, count, src.Len())
+		}
+		prev = db
+	}
+	for _, key := range src.Keys() {
+		want, _ := src.Get(key)
+		have, err := prev.Get(key)
+		if err != nil {
+			t.Fatalf("key %q missing after round trip: %v", key, err)
+		}
+		if !bytes.Equal(have, want) {
+			t.Errorf("key %q value mismatch: have %x, want %x", key, have, want)
+		}
+	}
+	// A database can't be reopened with another engine.
+	if _, err := ethdb.Open(ethdb.EngineLevelDB, filepath.Join(dir, "badger"), 0, 0); err == nil {
+		t.Errorf("opened badger database with leveldb")
+	}
+}
@@ -25,15 +25,53 @@ type Putter interface {
 	Put(key []byte, value []byte) error
 }
 
+// SyncPutter wraps the database write operation of the databases able to
+// persist an entry to disk before returning.
+type SyncPutter interface {
+	PutSync(key []byte, value []byte) error
+}
+
 // Deleter wraps the database delete operation supported by both batches and regular databases.
 type Deleter interface {
 	Delete(key []byte) error
 }
 
+// Iterator iterates over a database's key/value pairs in ascending key order.
+// An iterator must be released after use, but it is not necessary to read it
+// until exhaustion.
+type Iterator interface {
+	// Next moves the iterator to the next key/value pair. It returns whether
+	// the iterator is exhausted.
+	Next() bool
+
+	// Error returns any accumulated error. Exhausting all the key/value pairs
+	// is not considered to be an error.
+	Error() error
+
+	// Key returns the key of the current key/value pair, or nil if done. The
+	// caller should not modify the contents of the returned slice.
+	Key() []byte
+
+	// Value returns the value of the current key/value pair, or nil if done.
+	// The caller should not modify the contents of the returned slice.
+	Value() []byte
+
+	// Release releases associated resources.
+	Release()
+}
+
+// Iteratee wraps the NewIteratorWithPrefix method of a backing data store.
+type Iteratee interface {
+	// NewIteratorWithPrefix creates an iterator over the subset of database
+	// content with a particular key prefix.
+	NewIteratorWithPrefix(prefix []byte) Iterator
+}
+
 // Database wraps all database operations. All methods are safe for concurrent use.
 type Database interface {
 	Putter
 	Deleter
+	Iteratee
 	Get(key []byte) ([]byte, error)
 	Has(key []byte) (bool, error)
 	Close()
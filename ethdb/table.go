@@ -46,6 +46,33 @@ func (dt *table) Delete(key []byte) error {
 	return dt.db.Delete(append([]byte(dt.prefix), key...))
 }
 
+// NewIteratorWithPrefix creates an iterator over the table entries whose keys
+// start with the given prefix. Returned keys have the table prefix stripped.
+func (dt *table) NewIteratorWithPrefix(prefix []byte) Iterator {
+	return &tableIterator{
+		it:     dt.db.NewIteratorWithPrefix(append([]byte(dt.prefix), prefix...)),
+		prefix: dt.prefix,
+	}
+}
+
 func (dt *table) Close() {
 	// Do nothing; don't close the underlying DB.
 }
+
+type tableIterator struct {
+	it     Iterator
+	prefix string
+}
+
+func (it *tableIterator) Next() bool    { return it.it.Next() }
+func (it *tableIterator) Error() error  { return it.it.Error() }
+func (it *tableIterator) Value() []byte { return it.it.Value() }
+func (it *tableIterator) Release()      { it.it.Release() }
+
+func (it *tableIterator) Key() []byte {
+	key := it.it.Key()
+	if key == nil {
+		return nil
+	}
+	return key[len(it.prefix):]
+}
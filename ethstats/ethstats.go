@@ -14,7 +14,8 @@
 // You should have received a copy of the GNU Lesser General Public License
 // along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
 
-// Package ethstats implements the network stats reporting service.
+// Package ethstats implements the network stats reporting service. The
+// reporting protocol, including the DEXON extensions, is described in README.md.
 package ethstats
 
 import (
@@ -35,6 +36,7 @@ import (
 	"github.com/dexon-foundation/dexon/consensus"
 	"github.com/dexon-foundation/dexon/core"
 	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/dex"
 	"github.com/dexon-foundation/dexon/eth"
 	"github.com/dexon-foundation/dexon/event"
 	"github.com/dexon-foundation/dexon/les"
@@ -72,6 +74,7 @@ type Service struct {
 	server *p2p.Server        // Peer-to-peer server to retrieve networking infos
 	eth    *eth.Ethereum      // Full Ethereum service if monitoring a full node
 	les    *les.LightEthereum // Light Ethereum service if monitoring a light node
+	dex    *dex.Dexon         // DEXON service if monitoring a DEXON full node
 	engine consensus.Engine   // Consensus engine to retrieve variadic block fields
 
 	node string // Name of the node to display on the monitoring page
@@ -83,7 +86,7 @@ type Service struct {
 }
 
 // New returns a monitoring service ready for stats reporting.
-func New(url string, ethServ *eth.Ethereum, lesServ *les.LightEthereum) (*Service, error) {
+func New(url string, ethServ *eth.Ethereum, lesServ *les.LightEthereum, dexServ *dex.Dexon) (*Service, error) {
 	// Parse the netstats connection url
 	re := regexp.MustCompile("([^:@]*)(:([^@]*))?@(.+)")
 	parts := re.FindStringSubmatch(url)
@@ -92,14 +95,18 @@ func New(url string, ethServ *eth.Ethereum, lesServ *les.LightEthereum) (*Servic
 	}
 	// Assemble and return the stats service
 	var engine consensus.Engine
-	if ethServ != nil {
+	switch {
+	case dexServ != nil:
+		engine = dexServ.Engine()
+	case ethServ != nil:
 		engine = ethServ.Engine()
-	} else {
+	default:
 		engine = lesServ.Engine()
 	}
 	return &Service{
 		eth:    ethServ,
 		les:    lesServ,
+		dex:    dexServ,
 		engine: engine,
 		node:   parts[1],
 		pass:   parts[3],
@@ -138,7 +145,10 @@ func (s *Service) loop() {
 	// Subscribe to chain events to execute updates on
 	var blockchain blockChain
 	var txpool txPool
-	if s.eth != nil {
+	if s.dex != nil {
+		blockchain = s.dex.BlockChain()
+		txpool = s.dex.TxPool()
+	} else if s.eth != nil {
 		blockchain = s.eth.BlockChain()
 		txpool = s.eth.TxPool()
 	} else {
@@ -373,7 +383,10 @@ func (s *Service) login(conn *websocket.Conn) error {
 	infos := s.server.NodeInfo()
 
 	var network, protocol string
-	if info := infos.Protocols["eth"]; info != nil {
+	if info := infos.Protocols["dex"]; info != nil {
+		network = fmt.Sprintf("%d", info.(*dex.NodeInfo).Network)
+		protocol = fmt.Sprintf("dex/%d", dex.ProtocolVersions[0])
+	} else if info := infos.Protocols["eth"]; info != nil {
 		network = fmt.Sprintf("%d", info.(*eth.NodeInfo).Network)
 		protocol = fmt.Sprintf("eth/%d", eth.ProtocolVersions[0])
 	} else {
@@ -481,6 +494,19 @@ type blockStats struct {
 	TxHash     common.Hash    `json:"transactionsRoot"`
 	Root       common.Hash    `json:"stateRoot"`
 	Uncles     uncleStats     `json:"uncles"`
+
+	// DEXON specific fields
+	Round         uint64         `json:"round"`
+	HasRandomness bool           `json:"hasRandomness"`
+	Proposer      *proposerStats `json:"proposer,omitempty"`
+}
+
+// proposerStats is the registered node information of a block proposer.
+type proposerStats struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Location string `json:"location"`
+	URL      string `json:"url"`
 }
 
 // txStats is the information to report about individual transactions.
@@ -527,13 +553,14 @@ func (s *Service) assembleBlockStats(block *types.Block) *blockStats {
 		txs    []txStats
 		uncles []*types.Header
 	)
-	if s.eth != nil {
+	if s.eth != nil || s.dex != nil {
 		// Full nodes have all needed information available
+		chain := s.fullChain()
 		if block == nil {
-			block = s.eth.BlockChain().CurrentBlock()
+			block = chain.CurrentBlock()
 		}
 		header = block.Header()
-		td = s.eth.BlockChain().GetTd(header.Hash(), header.Number.Uint64())
+		td = chain.GetTd(header.Hash(), header.Number.Uint64())
 
 		txs = make([]txStats, len(block.Transactions()))
 		for i, tx := range block.Transactions() {
@@ -553,6 +580,10 @@ func (s *Service) assembleBlockStats(block *types.Block) *blockStats {
 	// Assemble and return the block stats
 	author, _ := s.engine.Author(header)
 
+	var proposer *proposerStats
+	if s.dex != nil && header.Coinbase != (common.Address{}) {
+		proposer = s.assembleProposerStats(header.Coinbase)
+	}
 	return &blockStats{
 		Number:     header.Number,
 		Hash:       header.Hash(),
@@ -567,6 +598,35 @@ func (s *Service) assembleBlockStats(block *types.Block) *blockStats {
 		TxHash:     header.TxHash,
 		Root:       header.Root,
 		Uncles:     uncles,
+
+		Round:         header.Round,
+		HasRandomness: len(header.Randomness) > 0,
+		Proposer:      proposer,
+	}
+}
+
+// fullChain returns the block chain of the monitored full node.
+func (s *Service) fullChain() *core.BlockChain {
+	if s.dex != nil {
+		return s.dex.BlockChain()
+	}
+	return s.eth.BlockChain()
+}
+
+// assembleProposerStats looks up the node registered by the given owner in the
+// latest governance state. Nil is returned if the node is no longer registered.
+func (s *Service) assembleProposerStats(owner common.Address) *proposerStats {
+	gs := s.dex.Governance().GetHeadState()
+	offset := gs.NodesOffsetByAddress(owner)
+	if offset.Sign() < 0 {
+		return nil
+	}
+	node := gs.Node(offset)
+	return &proposerStats{
+		Name:     node.Name,
+		Email:    node.Email,
+		Location: node.Location,
+		URL:      node.Url,
 	}
 }
 
@@ -581,8 +641,8 @@ func (s *Service) reportHistory(conn *websocket.Conn, list []uint64) error {
 	} else {
 		// No indexes requested, send back the top ones
 		var head int64
-		if s.eth != nil {
-			head = s.eth.BlockChain().CurrentHeader().Number.Int64()
+		if s.eth != nil || s.dex != nil {
+			head = s.fullChain().CurrentHeader().Number.Int64()
 		} else {
 			head = s.les.BlockChain().CurrentHeader().Number.Int64()
 		}
@@ -599,8 +659,8 @@ func (s *Service) reportHistory(conn *websocket.Conn, list []uint64) error {
 	for i, number := range indexes {
 		// Retrieve the next block if it's known to us
 		var block *types.Block
-		if s.eth != nil {
-			block = s.eth.BlockChain().GetBlockByNumber(number)
+		if s.eth != nil || s.dex != nil {
+			block = s.fullChain().GetBlockByNumber(number)
 		} else {
 			if header := s.les.BlockChain().GetHeaderByNumber(number); header != nil {
 				block = types.NewBlockWithHeader(header)
@@ -641,7 +701,9 @@ type pendStats struct {
 func (s *Service) reportPending(conn *websocket.Conn) error {
 	// Retrieve the pending count from the local blockchain
 	var pending int
-	if s.eth != nil {
+	if s.dex != nil {
+		pending, _ = s.dex.TxPool().Stats()
+	} else if s.eth != nil {
 		pending, _ = s.eth.TxPool().Stats()
 	} else {
 		pending = s.les.TxPool().Stats()
@@ -670,6 +732,22 @@ type nodeStats struct {
 	Peers    int  `json:"peers"`
 	GasPrice int  `json:"gasPrice"`
 	Uptime   int  `json:"uptime"`
+
+	// DEXON specific fields
+	Round       uint64    `json:"round"`
+	RoundHeight uint64    `json:"roundHeight"`
+	Notary      bool      `json:"notary"`
+	NextNotary  bool      `json:"nextNotary"`
+	CoreSyncing bool      `json:"coreSyncing"`
+	Proposing   bool      `json:"proposing"`
+	DKG         *dkgStats `json:"dkg,omitempty"`
+}
+
+// dkgStats is the progress of the ongoing DKG protocol.
+type dkgStats struct {
+	Round      uint64 `json:"round"`
+	ResetCount uint64 `json:"resetCount"`
+	Phase      string `json:"phase"`
 }
 
 // reportPending retrieves various stats about the node at the networking and
@@ -682,7 +760,18 @@ func (s *Service) reportStats(conn *websocket.Conn) error {
 		syncing  bool
 		gasprice int
 	)
-	if s.eth != nil {
+	stats := &nodeStats{}
+	if s.dex != nil {
+		mining = s.dex.IsProposing()
+
+		sync := s.dex.Downloader().Progress()
+		syncing = s.dex.BlockChain().CurrentHeader().Number.Uint64() >= sync.HighestBlock
+
+		price, _ := s.dex.APIBackend.SuggestPrice(context.Background())
+		gasprice = int(price.Uint64())
+
+		s.assembleDexonStats(stats)
+	} else if s.eth != nil {
 		mining = s.eth.Miner().Mining()
 		hashrate = int(s.eth.Miner().HashRate())
 
@@ -698,20 +787,57 @@ func (s *Service) reportStats(conn *websocket.Conn) error {
 	// Assemble the node stats and send it to the server
 	log.Trace("Sending node details to ethstats")
 
-	stats := map[string]interface{}{
-		"id": s.node,
-		"stats": &nodeStats{
-			Active:   true,
-			Mining:   mining,
-			Hashrate: hashrate,
-			Peers:    s.server.PeerCount(),
-			GasPrice: gasprice,
-			Syncing:  syncing,
-			Uptime:   100,
-		},
-	}
+	stats.Active = true
+	stats.Mining = mining
+	stats.Hashrate = hashrate
+	stats.Peers = s.server.PeerCount()
+	stats.GasPrice = gasprice
+	stats.Syncing = syncing
+	stats.Uptime = 100
+
 	report := map[string][]interface{}{
-		"emit": {"stats", stats},
+		"emit": {"stats", map[string]interface{}{
+			"id":    s.node,
+			"stats": stats,
+		}},
 	}
 	return websocket.JSON.Send(conn, report)
 }
+
+// assembleDexonStats fills in the round, notary and DKG status of a DEXON node.
+func (s *Service) assembleDexonStats(stats *nodeStats) {
+	gov := s.dex.Governance()
+
+	stats.Round = s.dex.BlockChain().CurrentBlock().Round()
+	stats.RoundHeight = gov.GetRoundHeight(stats.Round)
+	stats.CoreSyncing = s.dex.IsCoreSyncing()
+	stats.Proposing = s.dex.IsProposing()
+
+	if notary, err := gov.IsNotary(stats.Round); err != nil {
+		log.Debug("Failed to retrieve notary set", "round", stats.Round, "err", err)
+	} else {
+		stats.Notary = notary
+	}
+	// The next notary set is unknown until the CRS of the next round is ready.
+	if notary, err := gov.IsNotary(stats.Round + 1); err != nil {
+		log.Debug("Failed to retrieve notary set", "round", stats.Round+1, "err", err)
+	} else {
+		stats.NextNotary = notary
+	}
+
+	round := gov.GetHeadState().DKGRound().Uint64()
+	dkg := &dkgStats{
+		Round:      round,
+		ResetCount: gov.DKGResetCount(round),
+		Phase:      "mpk",
+	}
+	switch {
+	case gov.IsDKGSuccess(round):
+		dkg.Phase = "success"
+	case gov.IsDKGFinal(round):
+		dkg.Phase = "final"
+	case gov.IsDKGMPKReady(round):
+		dkg.Phase = "mpkReady"
+	}
+	stats.DKG = dkg
+}
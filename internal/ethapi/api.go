@@ -27,6 +27,7 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/dexon-foundation/dexon/accounts"
+	"github.com/dexon-foundation/dexon/accounts/abi"
 	"github.com/dexon-foundation/dexon/accounts/keystore"
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/common/hexutil"
@@ -788,13 +789,79 @@ func (s *PublicBlockChainAPI) doCall(ctx context.Context, args CallArgs, blockNr
 // Call executes the given transaction on the state for the given block number.
 // It doesn't make and changes in the state/blockchain and is useful to execute and retrieve values.
 func (s *PublicBlockChainAPI) Call(ctx context.Context, args CallArgs, blockNr rpc.BlockNumber) (hexutil.Bytes, error) {
-	result, _, _, err := s.doCall(ctx, args, blockNr, 5*time.Second, s.b.RPCGasCap())
+	result, _, failed, err := s.doCall(ctx, args, blockNr, 5*time.Second, s.b.RPCGasCap())
+	if err == nil && failed {
+		if err := newRevertError(result); err != nil {
+			return nil, err
+		}
+	}
 	return (hexutil.Bytes)(result), err
 }
 
+// revertError is an API error carrying the revert data of a failed execution,
+// which is returned to RPC clients as the error data.
+type revertError struct {
+	error
+	data string // Hex encoded revert data
+}
+
+// ErrorCode returns the JSON error code of a reverted execution.
+func (e *revertError) ErrorCode() int {
+	return 3
+}
+
+// ErrorData returns the hex encoded revert data.
+func (e *revertError) ErrorData() interface{} {
+	return e.data
+}
+
+// newRevertError returns an error carrying the revert data of a failed
+// execution, decoding the revert reason into the message if there is one.
+// It returns nil if the execution did not return any data.
+func newRevertError(result []byte) *revertError {
+	if len(result) == 0 {
+		return nil
+	}
+	err := errors.New("execution reverted")
+	if reason, errUnpack := abi.UnpackRevert(result); errUnpack == nil {
+		err = fmt.Errorf("execution reverted: %v", reason)
+	}
+	return &revertError{error: err, data: hexutil.Encode(result)}
+}
+
 // EstimateGas returns an estimate of the amount of gas needed to execute the
 // given transaction against the current pending block.
-func (s *PublicBlockChainAPI) EstimateGas(ctx context.Context, args CallArgs) (hexutil.Uint64, error) {
+//
+// As unused gas is not refunded, the estimate is the lowest gas limit the
+// transaction succeeds with. The optional margin increases the estimate by
+// the given percentage, capped at the allowance.
+func (s *PublicBlockChainAPI) EstimateGas(ctx context.Context, args CallArgs, margin *hexutil.Uint64) (hexutil.Uint64, error) {
+	gas, cap, err := s.estimateGas(ctx, args)
+	if err != nil {
+		return 0, err
+	}
+	if margin != nil {
+		gas = addGasMargin(gas, uint64(*margin), cap)
+	}
+	return hexutil.Uint64(gas), nil
+}
+
+// addGasMargin increases gas by the given percentage, capped at cap.
+func addGasMargin(gas, margin, cap uint64) uint64 {
+	total := new(big.Int).SetUint64(gas)
+	total.Mul(total, new(big.Int).SetUint64(margin))
+	total.Div(total, big.NewInt(100))
+	total.Add(total, new(big.Int).SetUint64(gas))
+
+	if !total.IsUint64() || total.Uint64() > cap {
+		return cap
+	}
+	return total.Uint64()
+}
+
+// estimateGas returns the lowest gas limit the transaction succeeds with and
+// the allowance it was searched up to.
+func (s *PublicBlockChainAPI) estimateGas(ctx context.Context, args CallArgs) (uint64, uint64, error) {
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo  uint64 = params.TxGas - 1
@@ -807,7 +874,7 @@ func (s *PublicBlockChainAPI) EstimateGas(ctx context.Context, args CallArgs) (h
 		// Retrieve the current pending block to act as the gas ceiling
 		block, err := s.b.BlockByNumber(ctx, rpc.LatestBlockNumber)
 		if err != nil {
-			return 0, err
+			return 0, 0, err
 		}
 		hi = block.GasLimit()
 	}
@@ -819,19 +886,19 @@ func (s *PublicBlockChainAPI) EstimateGas(ctx context.Context, args CallArgs) (h
 	cap = hi
 
 	// Create a helper to check if a gas allowance results in an executable transaction
-	executable := func(gas uint64) bool {
+	executable := func(gas uint64) ([]byte, bool) {
 		args.Gas = hexutil.Uint64(gas)
 
-		_, _, failed, err := s.doCall(ctx, args, rpc.PendingBlockNumber, 0, gasCap)
+		result, _, failed, err := s.doCall(ctx, args, rpc.PendingBlockNumber, 0, gasCap)
 		if err != nil || failed {
-			return false
+			return result, false
 		}
-		return true
+		return result, true
 	}
 	// Execute the binary search and hone in on an executable gas limit
 	for lo+1 < hi {
 		mid := (hi + lo) / 2
-		if !executable(mid) {
+		if _, ok := executable(mid); !ok {
 			lo = mid
 		} else {
 			hi = mid
@@ -839,21 +906,73 @@ func (s *PublicBlockChainAPI) EstimateGas(ctx context.Context, args CallArgs) (h
 	}
 	// Reject the transaction as invalid if it still fails at the highest allowance
 	if hi == cap {
-		if !executable(hi) {
-			return 0, fmt.Errorf("gas required exceeds allowance (%d) or always failing transaction", cap)
+		if result, ok := executable(hi); !ok {
+			if err := newRevertError(result); err != nil {
+				return 0, 0, err
+			}
+			return 0, 0, fmt.Errorf("gas required exceeds allowance (%d) or always failing transaction", cap)
 		}
 	}
-	return hexutil.Uint64(hi), nil
+	return hi, cap, nil
+}
+
+// FeeEstimate is the expected charge of a transaction.
+type FeeEstimate struct {
+	Gas       hexutil.Uint64 `json:"gas"`
+	GasPrice  *hexutil.Big   `json:"gasPrice"`
+	GasRefund hexutil.Uint64 `json:"gasRefund"`
+	Fee       *hexutil.Big   `json:"fee"`
+}
+
+// EstimateFee returns the expected total charge of executing the given
+// transaction against the current pending block, that is the gas limit times
+// the gas price minus the expected refund.
+//
+// If no gas limit is given it is estimated with the optional margin as in
+// EstimateGas, and if no gas price is given the suggested one is used.
+func (s *PublicBlockChainAPI) EstimateFee(ctx context.Context, args CallArgs, margin *hexutil.Uint64) (*FeeEstimate, error) {
+	if args.GasPrice.ToInt().Sign() == 0 {
+		price, err := s.b.SuggestPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		args.GasPrice = hexutil.Big(*price)
+	}
+	if args.Gas == 0 {
+		gas, err := s.EstimateGas(ctx, args, margin)
+		if err != nil {
+			return nil, err
+		}
+		args.Gas = gas
+	}
+	result, gasUsed, failed, err := s.doCall(ctx, args, rpc.PendingBlockNumber, 0, s.b.RPCGasCap())
+	if err != nil {
+		return nil, err
+	}
+	if failed {
+		if err := newRevertError(result); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("transaction fails with gas limit %d", uint64(args.Gas))
+	}
+	price := args.GasPrice.ToInt()
+	return &FeeEstimate{
+		Gas:       args.Gas,
+		GasPrice:  (*hexutil.Big)(price),
+		GasRefund: hexutil.Uint64(uint64(args.Gas) - gasUsed),
+		Fee:       (*hexutil.Big)(new(big.Int).Mul(price, new(big.Int).SetUint64(gasUsed))),
+	}, nil
 }
 
 // ExecutionResult groups all structured logs emitted by the EVM
 // while replaying a transaction in debug mode as well as transaction
 // execution status, the amount of gas used and the return value
 type ExecutionResult struct {
-	Gas         uint64         `json:"gas"`
-	Failed      bool           `json:"failed"`
-	ReturnValue string         `json:"returnValue"`
-	StructLogs  []StructLogRes `json:"structLogs"`
+	Gas          uint64            `json:"gas"`
+	Failed       bool              `json:"failed"`
+	ReturnValue  string            `json:"returnValue"`
+	StructLogs   []StructLogRes    `json:"structLogs"`
+	OracleFrames []*vm.OracleFrame `json:"oracleFrames,omitempty"`
 }
 
 // StructLogRes stores a structured log emitted by the EVM while replaying a
@@ -974,9 +1093,95 @@ func (s *PublicBlockChainAPI) rpcOutputBlock(b *types.Block, inclTx bool, fullTx
 		return nil, err
 	}
 	fields["totalDifficulty"] = (*hexutil.Big)(s.b.GetTd(b.Hash()))
+	if round, err := newRPCRound(s.b, b.Header()); err == nil {
+		fields["roundStart"] = round.Start
+		fields["roundEnd"] = round.End
+		fields["extendedRound"] = round.Extended
+	}
 	return fields, err
 }
 
+// RPCRound describes the round a block belongs to. Blocks produced after the
+// end of a round, while the next round is not ready yet, belong to the
+// extended period of the round: they are not rewarded and their transaction
+// fees are paid to the governance owner.
+type RPCRound struct {
+	Round    hexutil.Uint64 `json:"round"`
+	Start    hexutil.Uint64 `json:"roundStart"`
+	End      hexutil.Uint64 `json:"roundEnd"`
+	Extended bool           `json:"extendedRound"`
+}
+
+// newRPCRound returns the round information of the given header.
+func newRPCRound(b Backend, head *types.Header) (*RPCRound, error) {
+	start, end, err := b.GetRoundRange(head.Round)
+	if err != nil {
+		return nil, err
+	}
+	return &RPCRound{
+		Round:    hexutil.Uint64(head.Round),
+		Start:    hexutil.Uint64(start),
+		End:      hexutil.Uint64(end),
+		Extended: head.Number.Uint64() > end,
+	}, nil
+}
+
+// RPCRoundTransition is the notification sent to the subscribers of
+// NewRounds.
+type RPCRoundTransition struct {
+	Number hexutil.Uint64 `json:"number"`
+	Hash   common.Hash    `json:"hash"`
+	*RPCRound
+}
+
+// NewRounds sends a notification each time the chain head enters a new round
+// or the extended period of the current round.
+func (s *PublicBlockChainAPI) NewRounds(ctx context.Context) (*rpc.Subscription, error) {
+	last, err := newRPCRound(s.b, s.b.CurrentBlock().Header())
+	if err != nil {
+		return &rpc.Subscription{}, err
+	}
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		heads := make(chan core.ChainHeadEvent)
+		headsSub := s.b.SubscribeChainHeadEvent(heads)
+		defer headsSub.Unsubscribe()
+
+		for {
+			select {
+			case ev := <-heads:
+				head := ev.Block.Header()
+				round, err := newRPCRound(s.b, head)
+				if err != nil {
+					log.Warn("Failed to get round of chain head", "number", head.Number, "err", err)
+					continue
+				}
+				if last.Round == round.Round && last.Extended == round.Extended {
+					continue
+				}
+				last = round
+				notifier.Notify(rpcSub.ID, &RPCRoundTransition{
+					Number:   hexutil.Uint64(head.Number.Uint64()),
+					Hash:     head.Hash(),
+					RPCRound: round,
+				})
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
 	BlockHash        common.Hash     `json:"blockHash"`
@@ -0,0 +1,222 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/event"
+	"github.com/dexon-foundation/dexon/rpc"
+)
+
+func TestAddGasMargin(t *testing.T) {
+	tests := []struct {
+		gas, margin, cap uint64
+		want             uint64
+	}{
+		{21000, 0, 100000, 21000},
+		{21000, 10, 100000, 23100},
+		{21000, 100, 100000, 42000},
+		{21000, 1000, 100000, 100000},
+		// gas * margin overflows uint64
+		{math.MaxUint64 / 2, 50, math.MaxUint64, math.MaxUint64/2 + math.MaxUint64/4},
+		{1 << 40, math.MaxUint64, 8000000, 8000000},
+		{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64},
+	}
+	for i, tt := range tests {
+		if have := addGasMargin(tt.gas, tt.margin, tt.cap); have != tt.want {
+			t.Errorf("test %d: gas mismatch: have %d, want %d", i, have, tt.want)
+		}
+	}
+}
+
+func TestRevertError(t *testing.T) {
+	// Error("fine not paid") as encoded by Solidity
+	reason := hexutil.MustDecode("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000d" +
+		"66696e65206e6f74207061696400000000000000000000000000000000000000")
+
+	tests := []struct {
+		result  []byte
+		message string
+	}{
+		{reason, "execution reverted: fine not paid"},
+		{[]byte{0xde, 0xad}, "execution reverted"},
+	}
+	for i, tt := range tests {
+		err := newRevertError(tt.result)
+		if err == nil {
+			t.Fatalf("test %d: no error returned", i)
+		}
+		if err.Error() != tt.message {
+			t.Errorf("test %d: message mismatch: have %q, want %q", i, err.Error(), tt.message)
+		}
+		var rpcErr rpc.DataError = err
+		if data := rpcErr.ErrorData(); data != hexutil.Encode(tt.result) {
+			t.Errorf("test %d: data mismatch: have %v, want %x", i, data, tt.result)
+		}
+		if code := err.ErrorCode(); code != 3 {
+			t.Errorf("test %d: code mismatch: have %d, want 3", i, code)
+		}
+	}
+	if err := newRevertError(nil); err != nil {
+		t.Errorf("error returned without revert data: %v", err)
+	}
+}
+
+// testRoundBackend is a backend of rounds of ten blocks, starting at the given
+// heights.
+type testRoundBackend struct {
+	Backend
+
+	starts     []uint64
+	head       *types.Block
+	heads      event.Feed
+	subscribed chan struct{}
+}
+
+func (b *testRoundBackend) GetRoundRange(round uint64) (uint64, uint64, error) {
+	if round >= uint64(len(b.starts)) {
+		return 0, 0, fmt.Errorf("round %d not started", round)
+	}
+	return b.starts[round], b.starts[round] + 9, nil
+}
+
+func (b *testRoundBackend) CurrentBlock() *types.Block {
+	return b.head
+}
+
+func (b *testRoundBackend) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
+	sub := b.heads.Subscribe(ch)
+	close(b.subscribed)
+	return sub
+}
+
+func newTestRoundBlock(round, number uint64) *types.Block {
+	return types.NewBlockWithHeader(&types.Header{
+		Number: new(big.Int).SetUint64(number),
+		Round:  round,
+	})
+}
+
+func TestRPCRound(t *testing.T) {
+	b := &testRoundBackend{starts: []uint64{0, 13}}
+
+	tests := []struct {
+		round, number uint64
+		start, end    uint64
+		extended      bool
+	}{
+		{0, 0, 0, 9, false},
+		{0, 9, 0, 9, false},
+		// Round 1 is not ready at height 10, round 0 is extended.
+		{0, 10, 0, 9, true},
+		{0, 12, 0, 9, true},
+		{1, 13, 13, 22, false},
+	}
+	for i, tt := range tests {
+		round, err := newRPCRound(b, newTestRoundBlock(tt.round, tt.number).Header())
+		if err != nil {
+			t.Fatalf("test %d: failed to get round: %v", i, err)
+		}
+		if uint64(round.Round) != tt.round || uint64(round.Start) != tt.start || uint64(round.End) != tt.end {
+			t.Errorf("test %d: round mismatch: have %d [%d, %d], want %d [%d, %d]",
+				i, round.Round, round.Start, round.End, tt.round, tt.start, tt.end)
+		}
+		if round.Extended != tt.extended {
+			t.Errorf("test %d: extended mismatch: have %v, want %v", i, round.Extended, tt.extended)
+		}
+	}
+	if _, err := newRPCRound(b, newTestRoundBlock(2, 23).Header()); err == nil {
+		t.Errorf("round not started returned")
+	}
+}
+
+func TestNewRounds(t *testing.T) {
+	b := &testRoundBackend{
+		starts:     []uint64{0, 13},
+		head:       newTestRoundBlock(0, 5),
+		subscribed: make(chan struct{}),
+	}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", NewPublicBlockChainAPI(b)); err != nil {
+		t.Fatalf("failed to register API: %v", err)
+	}
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	rounds := make(chan RPCRoundTransition)
+	sub, err := client.EthSubscribe(context.Background(), rounds, "newRounds")
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	select {
+	case <-b.subscribed:
+	case <-time.After(time.Second):
+		t.Fatalf("chain head events not subscribed")
+	}
+	// Only the heads entering the extended period of round 0 and round 1 are
+	// notified.
+	for _, head := range []*types.Block{
+		newTestRoundBlock(0, 6),
+		newTestRoundBlock(0, 10),
+		newTestRoundBlock(0, 11),
+		newTestRoundBlock(1, 13),
+		newTestRoundBlock(1, 14),
+	} {
+		b.heads.Send(core.ChainHeadEvent{Block: head})
+	}
+	want := []struct {
+		round, number uint64
+		extended      bool
+	}{
+		{0, 10, true},
+		{1, 13, false},
+	}
+	for i, w := range want {
+		select {
+		case have := <-rounds:
+			if uint64(have.Round) != w.round || uint64(have.Number) != w.number || have.Extended != w.extended {
+				t.Errorf("notification %d mismatch: have round %d number %d extended %v, want round %d number %d extended %v",
+					i, have.Round, have.Number, have.Extended, w.round, w.number, w.extended)
+			}
+			if have.Hash != newTestRoundBlock(w.round, w.number).Hash() {
+				t.Errorf("notification %d: hash mismatch: have %x", i, have.Hash)
+			}
+		case err := <-sub.Err():
+			t.Fatalf("subscription failed: %v", err)
+		case <-time.After(time.Second):
+			t.Fatalf("notification %d not received", i)
+		}
+	}
+	select {
+	case have := <-rounds:
+		t.Errorf("unexpected notification: %+v", have)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -19,6 +19,7 @@ package ethapi
 
 import (
 	"context"
+	"errors"
 	"math/big"
 
 	ethereum "github.com/dexon-foundation/dexon"
@@ -34,6 +35,10 @@ import (
 	"github.com/dexon-foundation/dexon/rpc"
 )
 
+// ErrRoundsNotSupported is returned by backends which do not follow the round
+// based DEXON consensus.
+var ErrRoundsNotSupported = errors.New("rounds not supported")
+
 type Downloader interface {
 	Progress() ethereum.SyncProgress
 }
@@ -63,6 +68,10 @@ type Backend interface {
 	SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription
 	SubscribeChainSideEvent(ch chan<- core.ChainSideEvent) event.Subscription
 
+	// GetRoundRange returns the first and the last block height of a started
+	// round, not including the blocks produced in its extended period.
+	GetRoundRange(round uint64) (start uint64, end uint64, err error)
+
 	// TxPool API
 	SendTx(ctx context.Context, signedTx *types.Transaction) error
 	SendTxs(ctx context.Context, signedTxs []*types.Transaction) []error
@@ -207,6 +207,11 @@ web3._extend({
 			name: 'stopProposing',
 			call: 'admin_stopProposing'
 		}),
+		new web3._extend.Method({
+			name: 'notarySet',
+			call: 'admin_notarySet',
+			params: 1
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
@@ -514,6 +519,12 @@ web3._extend({
 			params: 2,
 			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.utils.toHex]
 		}),
+		new web3._extend.Method({
+			name: 'estimateFee',
+			call: 'eth_estimateFee',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputCallFormatter, web3._extend.utils.fromDecimal]
+		}),
 		new web3._extend.Method({
 			name: 'getProof',
 			call: 'eth_getProof',
@@ -171,7 +171,7 @@ func NewNode(datadir string, config *NodeConfig) (stack *Node, _ error) {
 				var lesServ *les.LightEthereum
 				ctx.Service(&lesServ)
 
-				return ethstats.New(config.EthereumNetStats, nil, lesServ)
+				return ethstats.New(config.EthereumNetStats, nil, lesServ, nil)
 			}); err != nil {
 				return nil, fmt.Errorf("netstats init: %v", err)
 			}
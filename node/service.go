@@ -44,7 +44,7 @@ func (ctx *ServiceContext) OpenDatabase(name string, cache int, handles int) (et
 	if ctx.Config.DataDir == "" {
 		return ethdb.NewMemDatabase(), nil
 	}
-	db, err := ethdb.NewLDBDatabase(ctx.Config.ResolvePath(name), cache, handles)
+	db, err := ethdb.Open(ctx.Config.DBEngine, ctx.Config.ResolvePath(name), cache, handles)
 	if err != nil {
 		return nil, err
 	}
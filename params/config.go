@@ -232,18 +232,18 @@ var (
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), 0, big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, new(EthashConfig), nil, nil, nil}
+	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), 0, big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), big.NewInt(0), big.NewInt(0), false, new(EthashConfig), nil, nil, nil}
 
 	// AllCliqueProtocolChanges contains every protocol change (EIPs) introduced
 	// and accepted by the Ethereum core developers into the Clique consensus.
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), 0, big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, nil}
+	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), 0, big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), big.NewInt(0), big.NewInt(0), false, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, nil}
 
-	AllDexconProtocolChanges = &ChainConfig{big.NewInt(1337), 0, big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(DexconConfig), new(RecoveryConfig)}
+	AllDexconProtocolChanges = &ChainConfig{big.NewInt(1337), 0, big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), big.NewInt(0), big.NewInt(0), false, nil, nil, new(DexconConfig), new(RecoveryConfig)}
 
-	TestChainConfig = &ChainConfig{big.NewInt(1), 0, big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, new(EthashConfig), nil, nil, nil}
+	TestChainConfig = &ChainConfig{big.NewInt(1), 0, big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), big.NewInt(0), big.NewInt(0), false, new(EthashConfig), nil, nil, nil}
 	TestRules       = TestChainConfig.Rules(new(big.Int))
 
 	// Ethereum MainnetChainConfig is the chain parameters to run a node on the main network.
@@ -317,6 +317,14 @@ type ChainConfig struct {
 	PetersburgBlock     *big.Int `json:"petersburgBlock,omitempty"`     // Petersburg switch block (nil = same as Constantinople)
 	EWASMBlock          *big.Int `json:"ewasmBlock,omitempty"`          // EWASM switch block (nil = no fork, 0 = already activated)
 
+	RevertReasonBlock     *big.Int `json:"revertReasonBlock,omitempty"`     // Governance contract revert reasons switch block (nil = no fork, 0 = already activated)
+	RandomnessBeaconBlock *big.Int `json:"randomnessBeaconBlock,omitempty"` // Randomness beacon oracle activation block (nil = no fork, 0 = already activated)
+	BLSVerifyBlock        *big.Int `json:"blsVerifyBlock,omitempty"`        // BLS verification precompiles activation block (nil = no fork, 0 = already activated)
+
+	// LegacyEVM makes transactions follow the original Ethereum gas refund
+	// and fee rules instead of the DEXON ones, used by the Ethereum tests.
+	LegacyEVM bool `json:"legacyEvm,omitempty"`
+
 	// Various consensus engines
 	Ethash *EthashConfig `json:"ethash,omitempty"`
 	Clique *CliqueConfig `json:"clique,omitempty"`
@@ -416,7 +424,7 @@ func (c *ChainConfig) String() string {
 	default:
 		engine = "unknown"
 	}
-	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v Constantinople: %v  ConstantinopleFix: %v Engine: %v}",
+	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v Constantinople: %v  ConstantinopleFix: %v RevertReason: %v RandomnessBeacon: %v BLSVerify: %v Engine: %v}",
 		c.ChainID,
 		c.HomesteadBlock,
 		c.DAOForkBlock,
@@ -427,6 +435,9 @@ func (c *ChainConfig) String() string {
 		c.ByzantiumBlock,
 		c.ConstantinopleBlock,
 		c.PetersburgBlock,
+		c.RevertReasonBlock,
+		c.RandomnessBeaconBlock,
+		c.BLSVerifyBlock,
 		engine,
 	)
 }
@@ -478,6 +489,24 @@ func (c *ChainConfig) IsEWASM(num *big.Int) bool {
 	return isForked(c.EWASMBlock, num)
 }
 
+// IsRevertReason returns whether num is either equal to the governance contract
+// revert reasons switch block or greater.
+func (c *ChainConfig) IsRevertReason(num *big.Int) bool {
+	return isForked(c.RevertReasonBlock, num)
+}
+
+// IsRandomnessBeacon returns whether num is either equal to the randomness
+// beacon activation block or greater.
+func (c *ChainConfig) IsRandomnessBeacon(num *big.Int) bool {
+	return isForked(c.RandomnessBeaconBlock, num)
+}
+
+// IsBLSVerify returns whether num is either equal to the BLS verification
+// precompiles activation block or greater.
+func (c *ChainConfig) IsBLSVerify(num *big.Int) bool {
+	return isForked(c.BLSVerifyBlock, num)
+}
+
 // GasTable returns the gas table corresponding to the current phase (homestead or homestead reprice).
 //
 // The returned GasTable's fields shouldn't, under any circumstances, be changed.
@@ -549,6 +578,19 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.EWASMBlock, newcfg.EWASMBlock, head) {
 		return newCompatError("ewasm fork block", c.EWASMBlock, newcfg.EWASMBlock)
 	}
+	if isForkIncompatible(c.RevertReasonBlock, newcfg.RevertReasonBlock, head) {
+		return newCompatError("revert reason block", c.RevertReasonBlock, newcfg.RevertReasonBlock)
+	}
+	if isForkIncompatible(c.RandomnessBeaconBlock, newcfg.RandomnessBeaconBlock, head) {
+		return newCompatError("randomness beacon block", c.RandomnessBeaconBlock, newcfg.RandomnessBeaconBlock)
+	}
+	if isForkIncompatible(c.BLSVerifyBlock, newcfg.BLSVerifyBlock, head) {
+		return newCompatError("BLS verify block", c.BLSVerifyBlock, newcfg.BLSVerifyBlock)
+	}
+	// The gas rules apply from the first block after genesis.
+	if head.Sign() > 0 && c.LegacyEVM != newcfg.LegacyEVM {
+		return newCompatError("legacy EVM flag", common.Big1, common.Big1)
+	}
 	return nil
 }
 
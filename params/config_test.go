@@ -70,6 +70,18 @@ func TestCheckCompatible(t *testing.T) {
 				RewindTo:     9,
 			},
 		},
+		{stored: &ChainConfig{}, new: &ChainConfig{LegacyEVM: true}, head: 0, wantErr: nil},
+		{
+			stored: &ChainConfig{},
+			new:    &ChainConfig{LegacyEVM: true},
+			head:   10,
+			wantErr: &ConfigCompatError{
+				What:         "legacy EVM flag",
+				StoredConfig: big.NewInt(1),
+				NewConfig:    big.NewInt(1),
+				RewindTo:     0,
+			},
+		},
 	}
 
 	for _, test := range tests {
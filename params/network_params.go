@@ -51,4 +51,8 @@ const (
 	// HelperTrieProcessConfirmations is the number of confirmations before a HelperTrie
 	// is generated
 	HelperTrieProcessConfirmations = 256
+
+	// ImmutabilityThreshold is the number of blocks after which the blocks
+	// and receipts are moved from the key-value store into the ancient store.
+	ImmutabilityThreshold = 90000
 )
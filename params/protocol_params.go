@@ -86,6 +86,12 @@ const (
 	Bn256ScalarMulGas       uint64 = 40000  // Gas needed for an elliptic curve scalar multiplication
 	Bn256PairingBaseGas     uint64 = 100000 // Base price for an elliptic curve pairing check
 	Bn256PairingPerPointGas uint64 = 80000  // Per-point price for an elliptic curve pairing check
+
+	BLSVerifyGas          uint64 = 150000 // Gas needed for a BLS signature verification
+	BLSAggregatePerKeyGas uint64 = 150000 // Per-key price for an aggregated BLS signature verification, including the proof-of-possession check
+
+	RandomnessBeaconGas               uint64 = 800    // Gas needed for a randomness or CRS lookup of the randomness beacon
+	RandomnessBeaconGroupPublicKeyGas uint64 = 100000 // Gas needed for recovering a DKG group public key in the randomness beacon
 )
 
 var (
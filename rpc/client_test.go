@@ -49,6 +49,48 @@ func TestClientRequest(t *testing.T) {
 	}
 }
 
+func TestClientErrorData(t *testing.T) {
+	server := newTestServer("service", new(Service))
+	defer server.Stop()
+	client := DialInProc(server)
+	defer client.Close()
+
+	err := client.Call(nil, "service_returnError")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if err.Error() != "testError" {
+		t.Errorf("wrong error message: %q", err.Error())
+	}
+	ec, ok := err.(Error)
+	if !ok {
+		t.Fatalf("client error is not an Error: %#v", err)
+	}
+	if ec.ErrorCode() != 444 {
+		t.Errorf("wrong error code: %d", ec.ErrorCode())
+	}
+	de, ok := err.(DataError)
+	if !ok {
+		t.Fatalf("client error is not a DataError: %#v", err)
+	}
+	if de.ErrorData() != "testError data" {
+		t.Errorf("wrong error data: %#v", de.ErrorData())
+	}
+
+	// Errors without data are reported with the callback error code, even
+	// if they define their own.
+	err = client.Call(nil, "service_returnCodeError")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if ec, ok := err.(Error); !ok || ec.ErrorCode() != -32000 {
+		t.Errorf("wrong error: %#v", err)
+	}
+	if de, ok := err.(DataError); ok && de.ErrorData() != nil {
+		t.Errorf("unexpected error data: %#v", de.ErrorData())
+	}
+}
+
 func TestClientBatchRequest(t *testing.T) {
 	server := newTestServer("service", new(Service))
 	defer server.Stop()
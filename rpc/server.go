@@ -313,8 +313,16 @@ func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverReque
 	if req.callb.errPos >= 0 { // test if method returned an error
 		if !reply[req.callb.errPos].IsNil() {
 			e := reply[req.callb.errPos].Interface().(error)
-			res := codec.CreateErrorResponse(&req.id, &callbackError{e.Error()})
-			return res, nil
+			var rpcErr Error = &callbackError{e.Error()}
+			// Errors carrying data keep their own code, any other error is
+			// reported with the generic callback error code.
+			if de, ok := e.(DataError); ok {
+				if ec, ok := e.(Error); ok {
+					rpcErr = ec
+				}
+				return codec.CreateErrorResponseWithInfo(&req.id, rpcErr, de.ErrorData()), nil
+			}
+			return codec.CreateErrorResponse(&req.id, rpcErr), nil
 		}
 	}
 	return codec.CreateResponse(req.id, reply[0].Interface()), nil
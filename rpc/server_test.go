@@ -59,6 +59,25 @@ func (s *Service) Rets() (string, error) {
 	return "", nil
 }
 
+func (s *Service) ReturnError() error {
+	return testError{}
+}
+
+func (s *Service) ReturnCodeError() error {
+	return testCodeError{}
+}
+
+type testError struct{}
+
+func (testError) Error() string          { return "testError" }
+func (testError) ErrorCode() int         { return 444 }
+func (testError) ErrorData() interface{} { return "testError data" }
+
+type testCodeError struct{}
+
+func (testCodeError) Error() string  { return "testCodeError" }
+func (testCodeError) ErrorCode() int { return 555 }
+
 func (s *Service) InvalidRets1() (error, string) {
 	return nil, ""
 }
@@ -92,8 +111,8 @@ func TestServerRegisterName(t *testing.T) {
 		t.Fatalf("Expected service calc to be registered")
 	}
 
-	if len(svc.callbacks) != 5 {
-		t.Errorf("Expected 5 callbacks for service 'calc', got %d", len(svc.callbacks))
+	if len(svc.callbacks) != 7 {
+		t.Errorf("Expected 7 callbacks for service 'calc', got %d", len(svc.callbacks))
 	}
 
 	if len(svc.subscriptions) != 1 {
@@ -95,14 +95,17 @@ type btHeaderMarshaling struct {
 }
 
 func (t *BlockTest) Run() error {
-	config, ok := Forks[t.json.Network]
+	forkConfig, ok := Forks[t.json.Network]
 	if !ok {
 		return UnsupportedForkError{t.json.Network}
 	}
+	// The Ethereum block tests expect the original gas refund and fee rules.
+	config := *forkConfig
+	config.LegacyEVM = true
 
 	// import pre accounts & construct test genesis block & state root
 	db := ethdb.NewMemDatabase()
-	gblock, err := t.genesis(config).Commit(db)
+	gblock, err := t.genesis(&config).Commit(db)
 	if err != nil {
 		return err
 	}
@@ -118,7 +121,7 @@ func (t *BlockTest) Run() error {
 	} else {
 		engine = ethash.NewShared()
 	}
-	chain, err := core.NewBlockChain(db, &core.CacheConfig{TrieCleanLimit: 0}, config, engine, vm.Config{}, nil)
+	chain, err := core.NewBlockChain(db, &core.CacheConfig{TrieCleanLimit: 0}, &config, engine, vm.Config{}, nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,140 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// +build gofuzz
+
+package tests
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	dexCore "github.com/dexon-foundation/dexon-consensus/core"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/common/math"
+	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/params"
+)
+
+var (
+	fuzzKey      = hexutil.MustDecode("0x45a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8")
+	fuzzSender   = common.HexToAddress("0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b")
+	fuzzContract = common.HexToAddress("0x0000000000000000000000000000000000001000")
+	fuzzCoinbase = common.HexToAddress("0x2adc25665018aa1fe0e6bc666dac8fc2697ff9ba")
+	fuzzOwner    = common.HexToAddress("0x00000000000000000000000000000000000000ee")
+	fuzzBalance  = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+)
+
+// Fuzz is the entry point for the go-fuzz tool. The input selects
+// the round, the block, the gas of the transaction and the code of the called
+// contract. It panics if the DEXON gas and fee rules are violated.
+//
+// The layout of the input is:
+//   byte 0:    round in the lower 3 bits, legacy EVM rules if the top bit is set
+//   byte 1:    round length - 1
+//   byte 2:    block number offset from the round begin
+//   byte 3-4:  extra gas limit over 21000, in units of 16
+//   byte 5:    gas price - 1
+//   byte 6-:   contract code
+func Fuzz(input []byte) int {
+	if len(input) < 6 {
+		return 0
+	}
+	var (
+		legacy      = input[0]&0x80 != 0
+		round       = uint64(input[0] & 0x07)
+		roundLength = uint64(input[1]) + 1
+		roundHeight = round * 1000
+		number      = roundHeight + uint64(input[2]) + 1
+		gasLimit    = 21000 + uint64(binary.BigEndian.Uint16(input[3:5]))*16
+		gasPrice    = big.NewInt(int64(input[5]) + 1)
+		code        = input[6:]
+	)
+	config := *params.AllDexconProtocolChanges
+	config.LegacyEVM = legacy
+
+	test := &DexonStateTest{json: dxJSON{
+		Config: &config,
+		Env: stEnv{
+			Coinbase:   fuzzCoinbase,
+			Difficulty: big.NewInt(1),
+			GasLimit:   gasLimit,
+			Number:     number,
+			Timestamp:  1000,
+			Randomness: crypto.Keccak256(input),
+			Round:      round,
+		},
+		Governance: dxGovernance{
+			Owner:       fuzzOwner,
+			RoundHeight: math.HexOrDecimal64(roundHeight),
+			RoundLength: math.HexOrDecimal64(roundLength),
+		},
+		Pre: core.GenesisAlloc{
+			fuzzSender:   {Balance: fuzzBalance},
+			fuzzContract: {Balance: new(big.Int), Code: code},
+		},
+		Tx: stTransaction{
+			GasPrice:   gasPrice,
+			To:         fuzzContract.Hex(),
+			Data:       []string{"0x"},
+			GasLimit:   []uint64{gasLimit},
+			Value:      []string{"0x00"},
+			PrivateKey: fuzzKey,
+		},
+	}}
+	statedb, _, gasUsed, failed, err := test.execute(dxPostState{}, vm.Config{})
+	if err != nil {
+		panic(fmt.Sprintf("transaction not applied: %v", err))
+	}
+
+	// Unused gas is not refunded under the DEXON rules, only the refund
+	// counter capped to half of the gas used.
+	if gasUsed > gasLimit {
+		panic(fmt.Sprintf("gas used %d over gas limit %d", gasUsed, gasLimit))
+	}
+	if !legacy && gasUsed < gasLimit-gasLimit/2 {
+		panic(fmt.Sprintf("gas used %d refunded over half of gas limit %d", gasUsed, gasLimit))
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), gasPrice)
+	if paid := new(big.Int).Sub(fuzzBalance, statedb.GetBalance(fuzzSender)); paid.Cmp(fee) != 0 {
+		panic(fmt.Sprintf("sender paid %v, want fee %v", paid, fee))
+	}
+
+	// Fees of blocks past the round end go to the governance owner.
+	roundEnd := roundHeight + roundLength
+	if round < dexCore.ConfigRoundShift {
+		roundEnd++
+	}
+	receiver, other := fuzzCoinbase, fuzzOwner
+	if !legacy && number >= roundEnd {
+		receiver, other = fuzzOwner, fuzzCoinbase
+	}
+	if balance := statedb.GetBalance(receiver); balance.Cmp(fee) != 0 {
+		panic(fmt.Sprintf("fee receiver %x got %v, want %v", receiver, balance, fee))
+	}
+	if balance := statedb.GetBalance(other); balance.Sign() != 0 {
+		panic(fmt.Sprintf("%x got %v, want no fee", other, balance))
+	}
+	if failed {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,41 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dexon-foundation/dexon/core/vm"
+)
+
+func TestDexonState(t *testing.T) {
+	t.Parallel()
+
+	st := new(testMatcher)
+	st.walk(t, dexonStateTestDir, func(t *testing.T, name string, test *DexonStateTest) {
+		for _, subtest := range test.Subtests() {
+			subtest := subtest
+			t.Run(fmt.Sprint(subtest), func(t *testing.T) {
+				withTrace(t, test.gasLimit(subtest), func(vmconfig vm.Config) error {
+					_, err := test.Run(subtest, vmconfig)
+					return st.checkFailure(t, name, err)
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/common/hexutil"
+	"github.com/dexon-foundation/dexon/common/math"
+	"github.com/dexon-foundation/dexon/core"
+	"github.com/dexon-foundation/dexon/core/state"
+	"github.com/dexon-foundation/dexon/core/types"
+	"github.com/dexon-foundation/dexon/core/vm"
+	"github.com/dexon-foundation/dexon/ethdb"
+	"github.com/dexon-foundation/dexon/params"
+)
+
+// DexonStateTest checks transaction processing under the DEXON gas and fee
+// rules, i.e. the refund of the capped refund counter only, the fee receiver
+// of extended rounds, RAND and oracle contract calls.
+//
+// The format follows the general state tests, with the chain configuration
+// and the governance state the transaction is run against, and post
+// conditions on the execution result and the touched accounts instead of
+// the state root.
+type DexonStateTest struct {
+	json dxJSON
+}
+
+func (t *DexonStateTest) UnmarshalJSON(in []byte) error {
+	return json.Unmarshal(in, &t.json)
+}
+
+type dxJSON struct {
+	Config     *params.ChainConfig `json:"config"`
+	Env        stEnv               `json:"env"`
+	Governance dxGovernance        `json:"governance"`
+	Pre        core.GenesisAlloc   `json:"pre"`
+	Tx         stTransaction       `json:"transaction"`
+	Post       []dxPostState       `json:"post"`
+}
+
+// dxGovernance is the governance state the transaction is run against.
+type dxGovernance struct {
+	Owner       common.Address      `json:"owner"`
+	CRS         common.Hash         `json:"crs"`         // CRS of the current round, if any
+	RoundHeight math.HexOrDecimal64 `json:"roundHeight"` // height the current round begins at
+	RoundLength math.HexOrDecimal64 `json:"roundLength"` // zero means the round never ends
+}
+
+type dxPostState struct {
+	Indexes struct {
+		Data  int `json:"data"`
+		Gas   int `json:"gas"`
+		Value int `json:"value"`
+	}
+	GasUsed  math.HexOrDecimal64 `json:"gasUsed"`
+	Failed   bool                `json:"failed"`
+	Out      hexutil.Bytes       `json:"out"`
+	Accounts core.GenesisAlloc   `json:"accounts"`
+}
+
+// Subtests returns the indexes of all post states of the test.
+func (t *DexonStateTest) Subtests() []int {
+	sub := make([]int, len(t.json.Post))
+	for i := range sub {
+		sub[i] = i
+	}
+	return sub
+}
+
+// config returns the chain configuration of the test, defaulting to all the
+// protocol changes of DEXON.
+func (t *DexonStateTest) config() *params.ChainConfig {
+	if t.json.Config != nil {
+		return t.json.Config
+	}
+	return params.AllDexconProtocolChanges
+}
+
+// Run executes a specific subtest and checks the post conditions.
+func (t *DexonStateTest) Run(subtest int, vmconfig vm.Config) (*state.StateDB, error) {
+	post := t.json.Post[subtest]
+	statedb, ret, gasUsed, failed, err := t.execute(post, vmconfig)
+	if err != nil {
+		return statedb, fmt.Errorf("transaction failed: %v", err)
+	}
+	if gasUsed != uint64(post.GasUsed) {
+		return statedb, fmt.Errorf("gas used mismatch: got %d, want %d", gasUsed, uint64(post.GasUsed))
+	}
+	if failed != post.Failed {
+		return statedb, fmt.Errorf("failed mismatch: got %v, want %v", failed, post.Failed)
+	}
+	if !bytes.Equal(ret, post.Out) {
+		return statedb, fmt.Errorf("output mismatch: got %x, want %x", ret, []byte(post.Out))
+	}
+	return statedb, checkAccounts(statedb, post.Accounts)
+}
+
+// execute applies the transaction selected by the post state indexes.
+func (t *DexonStateTest) execute(post dxPostState, vmconfig vm.Config) (*state.StateDB, []byte, uint64, bool, error) {
+	config := t.config()
+	header := t.header()
+	statedb := MakePreState(ethdb.NewMemDatabase(), t.json.Pre)
+	vm.InitOracleContracts(config, statedb)
+	t.makeGovernanceState(statedb)
+
+	msg, err := t.json.Tx.toMessage(stPostState{Indexes: post.Indexes})
+	if err != nil {
+		return nil, nil, 0, false, err
+	}
+	context := core.NewEVMContext(msg, header, nil, &t.json.Env.Coinbase)
+	context.GetHash = vmTestBlockHash
+	// The test has a single state, the state of every round is read from it.
+	context.StateAtNumber = func(uint64) (*state.StateDB, error) {
+		return statedb, nil
+	}
+	// A round of zero length never ends, it lasts longer than any test chain.
+	roundLength := uint64(t.json.Governance.RoundLength)
+	if roundLength == 0 {
+		roundLength = math.MaxUint32
+	}
+	context.GetRoundConfig = func(uint64) *params.DexconConfig {
+		return &params.DexconConfig{RoundLength: roundLength}
+	}
+	evm := vm.NewEVM(context, statedb, config, vmconfig)
+
+	gaspool := new(core.GasPool)
+	gaspool.AddGas(header.GasLimit)
+	ret, gasUsed, failed, err := core.ApplyMessage(evm, msg, gaspool)
+	return statedb, ret, gasUsed, failed, err
+}
+
+func (t *DexonStateTest) gasLimit(subtest int) uint64 {
+	return t.json.Tx.GasLimit[t.json.Post[subtest].Indexes.Gas]
+}
+
+// makeGovernanceState writes the governance state of the test, the start
+// height of all the rounds up to the current one, the CRS and the owner.
+func (t *DexonStateTest) makeGovernanceState(statedb *state.StateDB) {
+	gs := vm.GovernanceState{StateDB: statedb}
+	for round := uint64(0); round < t.json.Env.Round; round++ {
+		gs.PushRoundHeight(new(big.Int))
+	}
+	gs.PushRoundHeight(new(big.Int).SetUint64(uint64(t.json.Governance.RoundHeight)))
+	if t.json.Governance.CRS != (common.Hash{}) {
+		gs.SetCRSRound(new(big.Int).SetUint64(t.json.Env.Round))
+		gs.SetCRS(t.json.Governance.CRS)
+	}
+	gs.SetOwner(t.json.Governance.Owner)
+}
+
+// header returns the header of the block the transaction is included in.
+// The genesis block cannot be used as the DEXON genesis requires staked
+// nodes.
+func (t *DexonStateTest) header() *types.Header {
+	return &types.Header{
+		Coinbase:   t.json.Env.Coinbase,
+		Difficulty: t.json.Env.Difficulty,
+		GasLimit:   t.json.Env.GasLimit,
+		Number:     new(big.Int).SetUint64(t.json.Env.Number),
+		Time:       t.json.Env.Timestamp,
+		Randomness: t.json.Env.Randomness,
+		Round:      t.json.Env.Round,
+	}
+}
+
+// checkAccounts checks the balance, nonce, storage and code of the expected
+// accounts. The code is only checked if it is given.
+func checkAccounts(statedb *state.StateDB, accounts core.GenesisAlloc) error {
+	for addr, account := range accounts {
+		if balance := statedb.GetBalance(addr); balance.Cmp(account.Balance) != 0 {
+			return fmt.Errorf("balance mismatch for %x: got %v, want %v", addr, balance, account.Balance)
+		}
+		if nonce := statedb.GetNonce(addr); nonce != account.Nonce {
+			return fmt.Errorf("nonce mismatch for %x: got %d, want %d", addr, nonce, account.Nonce)
+		}
+		for key, value := range account.Storage {
+			if got := statedb.GetState(addr, key); got != value {
+				return fmt.Errorf("storage mismatch for %x at %x: got %x, want %x", addr, key, got, value)
+			}
+		}
+		if len(account.Code) > 0 && !bytes.Equal(statedb.GetCode(addr), account.Code) {
+			return fmt.Errorf("code mismatch for %x", addr)
+		}
+	}
+	return nil
+}
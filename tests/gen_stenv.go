@@ -8,6 +8,7 @@ import (
 	"math/big"
 
 	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/common/hexutil"
 	"github.com/dexon-foundation/dexon/common/math"
 )
 
@@ -20,6 +21,8 @@ func (s stEnv) MarshalJSON() ([]byte, error) {
 		GasLimit   math.HexOrDecimal64      `json:"currentGasLimit"   gencodec:"required"`
 		Number     math.HexOrDecimal64      `json:"currentNumber"     gencodec:"required"`
 		Timestamp  math.HexOrDecimal64      `json:"currentTimestamp"  gencodec:"required"`
+		Randomness hexutil.Bytes            `json:"currentRandomness"`
+		Round      math.HexOrDecimal64      `json:"currentRound"`
 	}
 	var enc stEnv
 	enc.Coinbase = common.UnprefixedAddress(s.Coinbase)
@@ -27,6 +30,8 @@ func (s stEnv) MarshalJSON() ([]byte, error) {
 	enc.GasLimit = math.HexOrDecimal64(s.GasLimit)
 	enc.Number = math.HexOrDecimal64(s.Number)
 	enc.Timestamp = math.HexOrDecimal64(s.Timestamp)
+	enc.Randomness = s.Randomness
+	enc.Round = math.HexOrDecimal64(s.Round)
 	return json.Marshal(&enc)
 }
 
@@ -37,6 +42,8 @@ func (s *stEnv) UnmarshalJSON(input []byte) error {
 		GasLimit   *math.HexOrDecimal64      `json:"currentGasLimit"   gencodec:"required"`
 		Number     *math.HexOrDecimal64      `json:"currentNumber"     gencodec:"required"`
 		Timestamp  *math.HexOrDecimal64      `json:"currentTimestamp"  gencodec:"required"`
+		Randomness *hexutil.Bytes            `json:"currentRandomness"`
+		Round      *math.HexOrDecimal64      `json:"currentRound"`
 	}
 	var dec stEnv
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -62,5 +69,11 @@ func (s *stEnv) UnmarshalJSON(input []byte) error {
 		return errors.New("missing required field 'currentTimestamp' for stEnv")
 	}
 	s.Timestamp = uint64(*dec.Timestamp)
+	if dec.Randomness != nil {
+		s.Randomness = *dec.Randomness
+	}
+	if dec.Round != nil {
+		s.Round = uint64(*dec.Round)
+	}
 	return nil
 }
@@ -41,6 +41,8 @@ var (
 	vmTestDir          = filepath.Join(baseDir, "VMTests")
 	rlpTestDir         = filepath.Join(baseDir, "RLPTests")
 	difficultyTestDir  = filepath.Join(baseDir, "BasicTests")
+
+	dexonStateTestDir = filepath.Join(".", "dexon-testdata", "StateTests")
 )
 
 func readJSON(reader io.Reader, value interface{}) error {
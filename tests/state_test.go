@@ -72,16 +72,16 @@ func TestState(t *testing.T) {
 const traceErrorLimit = 400000
 
 // The VM config for state tests that accepts --vm.* command line arguments.
-var testVMConfig = func() vm.Config {
-	vmconfig := vm.Config{}
+// The flags are parsed together with the test flags.
+var testVMConfig = func() *vm.Config {
+	vmconfig := new(vm.Config)
 	flag.StringVar(&vmconfig.EVMInterpreter, utils.EVMInterpreterFlag.Name, utils.EVMInterpreterFlag.Value, utils.EVMInterpreterFlag.Usage)
 	flag.StringVar(&vmconfig.EWASMInterpreter, utils.EWASMInterpreterFlag.Name, utils.EWASMInterpreterFlag.Value, utils.EWASMInterpreterFlag.Usage)
-	flag.Parse()
 	return vmconfig
 }()
 
 func withTrace(t *testing.T, gasLimit uint64, test func(vm.Config) error) {
-	err := test(testVMConfig)
+	err := test(*testVMConfig)
 	if err == nil {
 		return
 	}